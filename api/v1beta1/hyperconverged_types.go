@@ -3,6 +3,8 @@ package v1beta1
 import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -67,7 +69,7 @@ type HyperConvergedSpec struct {
 
 	// featureGates is a map of feature gate flags. Setting a flag to `true` will enable
 	// the feature. Setting `false` or removing the feature gate, disables the feature.
-	// +kubebuilder:default={"downwardMetrics": false, "deployKubeSecondaryDNS": false, "disableMDevConfiguration": false, "persistentReservation": false, "enableMultiArchBootImageImport": false, "decentralizedLiveMigration": false, "declarativeHotplugVolumes": false, "videoConfig": true, "objectGraph": false}
+	// +kubebuilder:default={"downwardMetrics": false, "disableMDevConfiguration": false, "persistentReservation": false, "enableMultiArchBootImageImport": false, "decentralizedLiveMigration": false, "declarativeHotplugVolumes": false, "videoConfig": true, "objectGraph": false}
 	// +optional
 	FeatureGates HyperConvergedFeatureGates `json:"featureGates,omitempty"`
 
@@ -136,8 +138,14 @@ type HyperConvergedSpec struct {
 	// +optional
 	StorageImport *StorageImportConfig `json:"storageImport,omitempty"`
 
+	// ImportProxy configures the proxy of the CDI importer pods. On OpenShift, the importer pods use the cluster-wide
+	// proxy of the Proxy CR by default; ImportProxy overrides this configuration. On other clusters, the importer
+	// pods only use a proxy if ImportProxy is set. The effective configuration is reported in status.importProxy.
+	// +optional
+	ImportProxy *cdiv1beta1.ImportProxy `json:"importProxy,omitempty"`
+
 	// WorkloadUpdateStrategy defines at the cluster level how to handle automated workload updates
-	// +kubebuilder:default={"workloadUpdateMethods": {"LiveMigrate"}, "batchEvictionSize": 10, "batchEvictionInterval": "1m0s"}
+	// +kubebuilder:default={"workloadUpdateMethods": {"LiveMigrate"}, "batchEvictionSize": 10, "batchEvictionInterval": "1m0s", "updateStallThreshold": "1h0m0s"}
 	WorkloadUpdateStrategy HyperConvergedWorkloadUpdateStrategy `json:"workloadUpdateStrategy,omitempty"`
 
 	// DataImportCronTemplates holds list of data import cron templates (golden images)
@@ -170,11 +178,19 @@ type HyperConvergedSpec struct {
 
 	// TLSSecurityProfile specifies the settings for TLS connections to be propagated to all kubevirt-hyperconverged components.
 	// If unset, the hyperconverged cluster operator will consume the value set on the APIServer CR on OCP/OKD or Intermediate if on vanilla k8s.
-	// Note that only Old, Intermediate and Custom profiles are currently supported, and the maximum available
-	// MinTLSVersions is VersionTLS12.
+	// The Old, Intermediate, Modern and Custom profiles are supported. The Modern profile, or a Custom profile with
+	// VersionTLS13 as the minTLSVersion, restricts all the components to TLS 1.3.
 	// +optional
 	TLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile `json:"tlsSecurityProfile,omitempty"`
 
+	// ImagePullSecrets is a list of Secrets in the HyperConverged namespace, to pull the container images from
+	// private registries. The Secrets are set in the ServiceAccounts, and in the pods of the Deployments and the
+	// DaemonSets that HCO deploys, and passed to KubeVirt and to CDI. The other image pull secrets of the
+	// ServiceAccounts, e.g. the ones that OpenShift injects, are kept.
+	// +listType=atomic
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
 	// TektonPipelinesNamespace defines namespace in which example pipelines will be deployed.
 	// If unset, then the default value is the operator namespace.
 	// +optional
@@ -190,13 +206,38 @@ type HyperConvergedSpec struct {
 	TektonTasksNamespace *string `json:"tektonTasksNamespace,omitempty"`
 
 	// KubeSecondaryDNSNameServerIP defines name server IP used by KubeSecondaryDNS
+	// Deprecated: use spec.kubeSecondaryDNS.nameServerIP instead. This field is only used if
+	// spec.kubeSecondaryDNS.nameServerIP is not set.
 	// +optional
 	KubeSecondaryDNSNameServerIP *string `json:"kubeSecondaryDNSNameServerIP,omitempty"`
 
+	// KubeSecondaryDNS configures KubeSecondaryDNS, the DNS server for the secondary network interfaces of the
+	// virtual machines. KubeSecondaryDNS is deployed by the cluster-network-addons-operator.
+	// +optional
+	KubeSecondaryDNS *KubeSecondaryDNSConfig `json:"kubeSecondaryDNS,omitempty"`
+
 	// KubeMacPoolConfiguration holds kubemacpool MAC address range configuration.
 	// +optional
 	KubeMacPoolConfiguration *KubeMacPoolConfig `json:"kubeMacPoolConfiguration,omitempty"`
 
+	// Networking configures the network components that the cluster-network-addons-operator deploys.
+	// The state of each deployed component is reported in status.networking.
+	// +optional
+	Networking *NetworkingConfig `json:"networking,omitempty"`
+
+	// NetworkPolicies extends the NetworkPolicies that restrict the network traffic of the HyperConverged Cluster
+	// Operator pods, for clusters where these policies block legitimate traffic; e.g. clusters with a custom API
+	// server endpoint, an egress proxy or an additional monitoring stack.
+	// +optional
+	NetworkPolicies *NetworkPoliciesConfig `json:"networkPolicies,omitempty"`
+
+	// BaselineAdminNetworkPolicy makes HCO deploy the cluster BaselineAdminNetworkPolicy, for the namespaces that run
+	// virtual machines. The policy allows the traffic that KubeVirt needs, and denies any other traffic of the pods in
+	// these namespaces that is not allowed by a NetworkPolicy. The policy is only deployed if the
+	// BaselineAdminNetworkPolicy API (policy.networking.k8s.io/v1alpha1) is installed in the cluster.
+	// +optional
+	BaselineAdminNetworkPolicy *BaselineAdminNetworkPolicyConfig `json:"baselineAdminNetworkPolicy,omitempty"`
+
 	// EvictionStrategy defines at the cluster level if the VirtualMachineInstance should be
 	// migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
 	// field is set it overrides the cluster level one.
@@ -234,10 +275,20 @@ type HyperConvergedSpec struct {
 	KSMConfiguration *v1.KSMConfiguration `json:"ksmConfiguration,omitempty"`
 
 	// NetworkBinding defines the network binding plugins.
-	// Those bindings can be used when defining virtual machine interfaces.
+	// Those bindings can be used when defining virtual machine interfaces. A binding with the binding name of an
+	// enabled managed network binding plugin is ignored; the binding of the plugin is used instead.
 	// +optional
 	NetworkBinding map[string]v1.InterfaceBindingPlugin `json:"networkBinding,omitempty"`
 
+	// NetworkBindingPlugins is the list of the network binding plugins that HCO deploys and registers in KubeVirt.
+	// HCO deploys all the components that each plugin requires (e.g. the CNI plugin DaemonSet and the
+	// NetworkAttachmentDefinition), and adds the plugin to the KubeVirt network bindings.
+	// The state of each plugin is reported in status.networkBindingPlugins.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	NetworkBindingPlugins []NetworkBindingPlugin `json:"networkBindingPlugins,omitempty"`
+
 	// ApplicationAwareConfig set the AAQ configurations
 	// +optional
 	ApplicationAwareConfig *ApplicationAwareConfigurations `json:"applicationAwareConfig,omitempty"`
@@ -257,6 +308,13 @@ type HyperConvergedSpec struct {
 	// +default=true
 	EnableCommonBootImageImport *bool `json:"enableCommonBootImageImport,omitempty"`
 
+	// DataImportScheduleWindow is the daily time window for importing the common golden images. If set, HCO spreads
+	// the import schedules of the common DataImportCronTemplates within this window, and each common golden image is
+	// imported once a day. If not set, HCO uses the randomly generated status.dataImportSchedule for all the common
+	// DataImportCronTemplates.
+	// +optional
+	DataImportScheduleWindow *DataImportScheduleWindow `json:"dataImportScheduleWindow,omitempty"`
+
 	// InstancetypeConfig holds the configuration of instance type related functionality within KubeVirt.
 	// +optional
 	InstancetypeConfig *v1.InstancetypeConfiguration `json:"instancetypeConfig,omitempty"`
@@ -281,6 +339,36 @@ type HyperConvergedSpec struct {
 	// max guest memory and max hotplug ratio. This setting can affect VM CPU and memory settings.
 	// +optional
 	LiveUpdateConfiguration *v1.LiveUpdateConfiguration `json:"liveUpdateConfiguration,omitempty"`
+
+	// VMGovernancePolicies enables a set of ValidatingAdmissionPolicies, shipped by HCO, that enforce common virtual
+	// machine governance rules. Each policy is only enforced if it is set.
+	// +optional
+	VMGovernancePolicies *VMGovernancePolicies `json:"vmGovernancePolicies,omitempty"`
+
+	// VirtualMachineDefaults is a list of cluster-wide defaults for new virtual machines. HCO applies each item with
+	// a MutatingAdmissionPolicy, to the virtual machines that are created in the namespaces that are selected by the
+	// item. The defaults only set the fields that are not already set in the virtual machine.
+	// This field requires the MutatingAdmissionPolicy API (admissionregistration.k8s.io/v1beta1) to be enabled in
+	// the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	VirtualMachineDefaults []VirtualMachineDefaults `json:"virtualMachineDefaults,omitempty"`
+
+	// ProtectOperandCRs if true, HCO deploys a ValidatingAdmissionPolicy that denies direct modifications of the
+	// spec of the operand CRs that are owned by HCO (e.g. the KubeVirt and the CDI CRs), unless they are done by HCO
+	// itself. These CRs should be configured using the HyperConverged CR fields, or the jsonpatch annotations.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	ProtectOperandCRs *bool `json:"protectOperandCRs,omitempty"`
+
+	// ArchitectureConfiguration sets the default machine type, the UEFI firmware path and the allowed emulated
+	// machine types of the virtual machines, per CPU architecture. A field that is not set here, keeps the default
+	// value of the architecture, that is set by the operator deployment.
+	// +optional
+	ArchitectureConfiguration *ArchitectureConfiguration `json:"architectureConfiguration,omitempty"`
 }
 
 // CertRotateConfigCA contains the tunables for TLS certificates.
@@ -335,6 +423,36 @@ type HyperConvergedCertConfig struct {
 	// +kubebuilder:default={"duration": "24h0m0s", "renewBefore": "12h0m0s"}
 	// +optional
 	Server CertRotateConfigServer `json:"server,omitempty"`
+
+	// IssuerRef is a reference to a cert-manager Issuer or ClusterIssuer. When set, and cert-manager is installed in
+	// the cluster, HCO requests the serving certificate of its webhook from this issuer, using the server duration and
+	// renewBefore, instead of using the self-signed certificate.
+	// The operands keep using their internal self-signed certificates, as none of them supports an external CA.
+	// If cert-manager is not installed, or HCO is deployed by OLM, this field is ignored, and the
+	// CertIssuerRefIgnored condition is set.
+	// +optional
+	IssuerRef *CertManagerIssuerReference `json:"issuerRef,omitempty"`
+}
+
+// CertManagerIssuerReference is a reference to a cert-manager issuer
+// +k8s:openapi-gen=true
+type CertManagerIssuerReference struct {
+	// Name of the issuer
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Kind of the issuer; Issuer, in the HCO namespace, or ClusterIssuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default=Issuer
+	// +default="Issuer"
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group of the issuer. Only needed for external issuers, that are not part of cert-manager
+	// +kubebuilder:default=cert-manager.io
+	// +default="cert-manager.io"
+	// +optional
+	Group string `json:"group,omitempty"`
 }
 
 // HyperConvergedConfig defines a set of configurations to pass to components
@@ -454,10 +572,8 @@ type HyperConvergedFeatureGates struct {
 	// Use spec.deployVmConsoleProxy instead
 	DeployVMConsoleProxy *bool `json:"deployVmConsoleProxy,omitempty"`
 
-	// Deploy KubeSecondaryDNS by CNAO
-	// +optional
-	// +kubebuilder:default=false
-	// +default=false
+	// Deprecated: This field is ignored and will be removed on the next version of the API.
+	// Use spec.kubeSecondaryDNS.deploy instead
 	DeployKubeSecondaryDNS *bool `json:"deployKubeSecondaryDNS,omitempty"`
 
 	// Deprecated: this field is ignored and will be removed in the next version of the API.
@@ -715,6 +831,44 @@ type StorageImportConfig struct {
 	// +listType=set
 	// +optional
 	InsecureRegistries []string `json:"insecureRegistries,omitempty"`
+
+	// RegistryMirrors is a list of mirrors for the registries of the common golden images. HCO rewrites the source
+	// URL of each common DataImportCronTemplate that matches one of the sources, to pull the image from the mirror
+	// instead. This is useful for disconnected clusters, that can't reach the original registries. A rewritten
+	// DataImportCronTemplate is not considered as modified.
+	// +listType=map
+	// +listMapKey=source
+	// +optional
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty"`
+}
+
+// DataImportScheduleWindow defines a daily time window, for importing the common golden images
+// +k8s:openapi-gen=true
+type DataImportScheduleWindow struct {
+	// Start is the time of the day when the window opens, in the "HH:MM" format, in UTC.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// Duration is the length of the window. Must be at least one minute, and no longer than 24 hours.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m') && duration(self) <= duration('24h')",message="duration must be between 1m and 24h"
+	Duration metav1.Duration `json:"duration"`
+}
+
+// RegistryMirror defines a mirror for a registry, or for a repository in a registry
+// +k8s:openapi-gen=true
+type RegistryMirror struct {
+	// Source is the registry host, optionally followed by a repository path, to be replaced by the mirror;
+	// e.g. "quay.io", or "quay.io/containerdisks". If more than one source matches an image, the longest one is used.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="!self.contains('://')",message="source must not contain a URL scheme"
+	Source string `json:"source"`
+
+	// Mirror is the registry host, optionally followed by a repository path, to pull the images from; e.g.
+	// "mirror.example.com:5000/containerdisks".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="!self.contains('://')",message="mirror must not contain a URL scheme"
+	Mirror string `json:"mirror"`
 }
 
 // HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install
@@ -748,6 +902,15 @@ type HyperConvergedWorkloadUpdateStrategy struct {
 	// +default="1m0s"
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// UpdateStallThreshold is the time that the workload update may run without any progress, before it is
+	// considered as stalled. A stalled workload update is reported by the kubevirt_hco_workload_update_stalled
+	// metric, and raises the HCOWorkloadUpdateStalled alert.
+	//
+	// +kubebuilder:default="1h0m0s"
+	// +default="1h0m0s"
+	// +optional
+	UpdateStallThreshold *metav1.Duration `json:"updateStallThreshold,omitempty"`
 }
 
 // HyperConvergedStatus defines the observed state of HyperConverged
@@ -780,7 +943,8 @@ type HyperConvergedStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
 	// DataImportSchedule is the cron expression that is used in for the hard-coded data import cron templates. HCO
-	// generates the value of this field once and stored in the status field, so will survive restart.
+	// generates the value of this field once and stored in the status field, so will survive restart. This schedule is
+	// not in use if the spec.dataImportScheduleWindow field is set.
 	// +optional
 	DataImportSchedule string `json:"dataImportSchedule,omitempty"`
 
@@ -788,6 +952,11 @@ type HyperConvergedStatus struct {
 	// contains both the common and the custom templates, including any modification done by HCO.
 	DataImportCronTemplates []DataImportCronTemplateStatus `json:"dataImportCronTemplates,omitempty"`
 
+	// DataImportCronTemplatesCatalogue describes the external catalogue of the common DataImportCronTemplates, if
+	// exists. The field is empty if there is no external catalogue.
+	// +optional
+	DataImportCronTemplatesCatalogue *DataImportCronTemplatesCatalogueStatus `json:"dataImportCronTemplatesCatalogue,omitempty"`
+
 	// SystemHealthStatus reflects the health of HCO and its secondary resources, based on the aggregated conditions.
 	// +optional
 	SystemHealthStatus string `json:"systemHealthStatus,omitempty"`
@@ -799,6 +968,125 @@ type HyperConvergedStatus struct {
 
 	// NodeInfo holds information about the cluster nodes
 	NodeInfo NodeInfoStatus `json:"nodeInfo,omitempty"`
+
+	// WorkloadUpdate summarizes the progress of the automated workload update, after a KubeVirt upgrade. The field
+	// is empty if there is no VirtualMachineInstance running with an outdated virt-launcher.
+	// +optional
+	WorkloadUpdate *WorkloadUpdateStatus `json:"workloadUpdate,omitempty"`
+
+	// VirtualMachineDefaults reports which of the spec.virtualMachineDefaults items are active
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	VirtualMachineDefaults []VirtualMachineDefaultsStatus `json:"virtualMachineDefaults,omitempty"`
+
+	// TLSSecurityProfiles reports the effective TLS security profile of each component
+	// +listType=map
+	// +listMapKey=component
+	// +optional
+	TLSSecurityProfiles []ComponentTLSSecurityProfile `json:"tlsSecurityProfiles,omitempty"`
+
+	// NetworkBindingPlugins reports the state of each of the spec.networkBindingPlugins
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	NetworkBindingPlugins []NetworkBindingPluginStatus `json:"networkBindingPlugins,omitempty"`
+
+	// Networking reports the state of each of the network components that are deployed according to spec.networking
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Networking []NetworkComponentStatus `json:"networking,omitempty"`
+
+	// KubeSecondaryDNS reports the domain that KubeSecondaryDNS serves, and the address that the external DNS resolvers
+	// can delegate this domain to
+	// +optional
+	KubeSecondaryDNS *KubeSecondaryDNSStatus `json:"kubeSecondaryDNS,omitempty"`
+
+	// ImportProxy reports the effective proxy configuration of the CDI importer pods: spec.importProxy, or the
+	// cluster-wide proxy. The credentials in the proxy URLs are masked.
+	// +optional
+	ImportProxy *cdiv1beta1.ImportProxy `json:"importProxy,omitempty"`
+
+	// OperandImages lists the container images that HCO deploys, or passes to the operands, as configured in the
+	// environment of the HyperConverged Cluster Operator deployment, and the images of the operators of the operands
+	// (KubeVirt, CDI, CNAO, SSP, AAQ and the migration controller), as set in their deployments, with their digests.
+	// The images that the operators deploy for their operands are derived from the operator images, and are reported
+	// by the operands.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	OperandImages []OperandImage `json:"operandImages,omitempty"`
+}
+
+// OperandImage is a container image that HCO deploys, or passes to the operands
+type OperandImage struct {
+	// Name is the name of the component that uses the image, or the name of the operator deployment
+	Name string `json:"name"`
+
+	// Image is the image reference, as configured in the HyperConverged Cluster Operator deployment, or in the
+	// operator deployment
+	Image string `json:"image"`
+
+	// Digest is the digest of the image reference, if the image is referenced by digest. Otherwise, it is the digest
+	// of the image that a running pod that HCO deploys (e.g. the console plugin, or the wasp agent) pulled for this
+	// reference. It is empty if the digest is not known; e.g., for an operator image that is referenced by tag.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// NetworkBindingPluginName is the name of a network binding plugin that HCO can deploy
+// +kubebuilder:validation:Enum=passt;managedTap
+type NetworkBindingPluginName string
+
+const (
+	// NetworkBindingPluginPasst is the passt network binding plugin, for the primary user defined network
+	NetworkBindingPluginPasst NetworkBindingPluginName = "passt"
+	// NetworkBindingPluginManagedTap is the managed tap network binding. KubeVirt creates the tap device on the pod
+	// network interface, so no CNI plugin is required.
+	NetworkBindingPluginManagedTap NetworkBindingPluginName = "managedTap"
+)
+
+// NetworkBindingPlugin is a network binding plugin that HCO deploys
+// +k8s:openapi-gen=true
+type NetworkBindingPlugin struct {
+	// Name is the name of the network binding plugin
+	Name NetworkBindingPluginName `json:"name"`
+}
+
+// NetworkBindingPluginStatus is the state of a network binding plugin
+type NetworkBindingPluginStatus struct {
+	// Name is the name of the network binding plugin
+	Name NetworkBindingPluginName `json:"name"`
+
+	// BindingName is the name of the binding, to be used in the virtual machine interfaces
+	BindingName string `json:"bindingName"`
+
+	// Ready is true if all the components of the plugin are deployed and ready
+	Ready bool `json:"ready"`
+
+	// Message describes why the plugin is not ready
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ComponentTLSSecurityProfile is the effective TLS security profile of a component
+type ComponentTLSSecurityProfile struct {
+	// Component is the name of the component
+	Component string `json:"component"`
+
+	// Type is the type of the TLS security profile
+	Type openshiftconfigv1.TLSProfileType `json:"type"`
+
+	// MinTLSVersion is the minimal TLS version that is accepted by the component
+	MinTLSVersion openshiftconfigv1.TLSProtocolVersion `json:"minTLSVersion"`
+
+	// Ciphers is the list of the ciphers that are accepted by the component, using the OpenSSL cipher names. The
+	// list is empty if the minimal TLS version is VersionTLS13, because the TLS 1.3 cipher suites are not
+	// configurable.
+	// +listType=atomic
+	// +optional
+	Ciphers []string `json:"ciphers,omitempty"`
 }
 
 type Version struct {
@@ -822,8 +1110,19 @@ type LogVerbosityConfiguration struct {
 // DataImportCronStatus is the status field of the DIC template
 type DataImportCronStatus struct {
 	// Conditions is a list of conditions that describe the state of the DataImportCronTemplate.
+	// In addition to the Deployed condition, the UpToDate and the Progressing conditions are copied from the
+	// DataImportCron, and the DataSourceReady condition is copied from the Ready condition of the managed DataSource.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// LastImportTimestamp is the time of the last successful import of the golden image, as reported by the
+	// DataImportCron.
+	// +optional
+	LastImportTimestamp *metav1.Time `json:"lastImportTimestamp,omitempty"`
+
+	// CurrentDigest is the digest of the latest source image that was detected by the DataImportCron.
+	// +optional
+	CurrentDigest string `json:"currentDigest,omitempty"`
+
 	// CommonTemplate indicates whether this is a common template (true), or a custom one (false)
 	CommonTemplate bool `json:"commonTemplate,omitempty"`
 
@@ -850,6 +1149,30 @@ type DataImportCronTemplateStatus struct {
 	Status DataImportCronStatus `json:"status,omitempty"`
 }
 
+// DataImportCronTemplatesCatalogueStatus describes the external catalogue of the common DataImportCronTemplates
+type DataImportCronTemplatesCatalogueStatus struct {
+	// ConfigMapName is the name of the ConfigMap that holds the external catalogue.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// Revision is the revision of the external catalogue that is currently in effect. It is taken from the
+	// hco.kubevirt.io/catalogue-revision annotation of the ConfigMap, or it is the SHA-256 hash of the catalogue data,
+	// if the annotation is missing. Empty if no valid revision of the external catalogue was loaded.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// DataImportCronTemplates is the list of the names of the DataImportCronTemplates that were loaded from the
+	// external catalogue.
+	// +listType=atomic
+	// +optional
+	DataImportCronTemplates []string `json:"dataImportCronTemplates,omitempty"`
+
+	// ValidationError is the reason for rejecting the latest revision of the external catalogue. When the latest
+	// revision is rejected, HCO keeps using the last valid revision, if any.
+	// +optional
+	ValidationError string `json:"validationError,omitempty"`
+}
+
 // NodeInfoStatus holds information about the cluster nodes
 type NodeInfoStatus struct {
 	// WorkloadsArchitectures is a distinct list of the CPU architectures of the workloads nodes in the cluster.
@@ -858,6 +1181,47 @@ type NodeInfoStatus struct {
 	ControlPlaneArchitectures []string `json:"controlPlaneArchitectures,omitempty"`
 }
 
+// WorkloadUpdateStatus summarizes the progress of updating the running VirtualMachineInstances to the current
+// virt-launcher version
+type WorkloadUpdateStatus struct {
+	// OutdatedVirtualMachineInstances is the number of the VirtualMachineInstances that are still running with an
+	// outdated virt-launcher, as reported by KubeVirt.
+	OutdatedVirtualMachineInstances int `json:"outdatedVirtualMachineInstances"`
+
+	// PendingVirtualMachineInstances is the number of the outdated VirtualMachineInstances that are waiting to be
+	// migrated or evicted.
+	PendingVirtualMachineInstances int `json:"pendingVirtualMachineInstances"`
+
+	// MigratingVirtualMachineInstances is the number of the outdated VirtualMachineInstances that are currently
+	// migrating.
+	MigratingVirtualMachineInstances int `json:"migratingVirtualMachineInstances"`
+
+	// BlockedVirtualMachineInstances is the number of the outdated VirtualMachineInstances that can't be live
+	// migrated, nor evicted, using the configured workload update methods.
+	BlockedVirtualMachineInstances int `json:"blockedVirtualMachineInstances"`
+
+	// BlockedVirtualMachines is a sample of the blocked VirtualMachineInstances, with the reason they are blocked.
+	// +listType=atomic
+	// +optional
+	BlockedVirtualMachines []BlockedVirtualMachine `json:"blockedVirtualMachines,omitempty"`
+
+	// LastProgressTime is the last time the number of the outdated VirtualMachineInstances decreased.
+	// +optional
+	LastProgressTime *metav1.Time `json:"lastProgressTime,omitempty"`
+}
+
+// BlockedVirtualMachine identifies a VirtualMachineInstance that blocks the workload update
+type BlockedVirtualMachine struct {
+	// Namespace is the namespace of the VirtualMachineInstance
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the VirtualMachineInstance
+	Name string `json:"name"`
+
+	// Reason is a short explanation why the VirtualMachineInstance can't be updated
+	Reason string `json:"reason"`
+}
+
 // ApplicationAwareConfigurations holds the AAQ configurations
 // +k8s:openapi-gen=true
 type ApplicationAwareConfigurations struct {
@@ -887,9 +1251,209 @@ type HigherWorkloadDensityConfiguration struct {
 	MemoryOvercommitPercentage int `json:"memoryOvercommitPercentage,omitempty"`
 }
 
+// VirtualMachineDefaults defines default values for new virtual machines, in the selected namespaces
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.preference) || has(self.terminationGracePeriodSeconds) || has(self.networkInterfaceBinding) || has(self.labels)",message="at least one default must be set"
+type VirtualMachineDefaults struct {
+	// Name is the unique name of this item. It is used in the names of the MutatingAdmissionPolicy and its binding.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=40
+	Name string `json:"name"`
+
+	// NamespaceSelector selects the namespaces where the defaults are applied. If not set, the defaults are applied in
+	// all the namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Preference is the default preference of virtual machines that don't set a preference
+	// +optional
+	Preference *VirtualMachineDefaultPreference `json:"preference,omitempty"`
+
+	// TerminationGracePeriodSeconds is the default grace period of the virtual machine shutdown, in seconds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// NetworkInterfaceBinding is the binding of the pod network interface; either a binding method (bridge or
+	// masquerade), or the name of a network binding plugin. It is only applied to virtual machines with no interfaces
+	// and no networks, that do not disable the automatic attachment of the pod interface. HCO then adds an explicit
+	// "default" pod network interface with this binding.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	NetworkInterfaceBinding *string `json:"networkInterfaceBinding,omitempty"`
+
+	// Labels are added to the virtual machine and to its VMI template, if they are not already set; e.g. for cost
+	// tracking.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// VirtualMachineDefaultPreference is the default preference of virtual machines
+// +k8s:openapi-gen=true
+type VirtualMachineDefaultPreference struct {
+	// Name is the name of the preference
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Kind is the kind of the preference
+	// +kubebuilder:validation:Enum=VirtualMachineClusterPreference;VirtualMachinePreference
+	// +kubebuilder:default=VirtualMachineClusterPreference
+	// +default="VirtualMachineClusterPreference"
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// VirtualMachineDefaultsStatus reports the state of the MutatingAdmissionPolicy of a spec.virtualMachineDefaults item
+// +k8s:openapi-gen=true
+type VirtualMachineDefaultsStatus struct {
+	// Name is the name of the spec.virtualMachineDefaults item
+	Name string `json:"name"`
+
+	// PolicyName is the name of the MutatingAdmissionPolicy that applies the defaults
+	// +optional
+	PolicyName string `json:"policyName,omitempty"`
+
+	// Active is true if the MutatingAdmissionPolicy and its binding are deployed
+	Active bool `json:"active"`
+
+	// Message explains why the defaults are not active
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VMGovernancePolicies holds the configuration of the virtual machine governance policies. The policies validate the
+// creation of virtual machines, and the updates of their spec.template.
+// +k8s:openapi-gen=true
+type VMGovernancePolicies struct {
+	// ResourceLimits limits the number of vCPUs and the amount of memory of each virtual machine
+	// +optional
+	ResourceLimits *VMResourceLimitsPolicy `json:"resourceLimits,omitempty"`
+
+	// HostDevices denies host devices, GPUs and hostDisk volumes that are not in the allowlist
+	// +optional
+	HostDevices *VMHostDevicesPolicy `json:"hostDevices,omitempty"`
+
+	// EvictionStrategy requires virtual machines to explicitly set their eviction strategy
+	// +optional
+	EvictionStrategy *VMEvictionStrategyPolicy `json:"evictionStrategy,omitempty"`
+
+	// NetworkBindings limits the network interface bindings that virtual machines may use
+	// +optional
+	NetworkBindings *VMNetworkBindingsPolicy `json:"networkBindings,omitempty"`
+}
+
+// VMResourceLimitsPolicy limits the number of vCPUs and the amount of memory of each virtual machine. Resources that
+// are set by an instance type are not checked.
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.maxCPUs) || has(self.maxMemory)",message="at least one of maxCPUs or maxMemory must be set"
+type VMResourceLimitsPolicy struct {
+	// NamespaceSelector selects the namespaces where the policy is enforced. If not set, the policy is enforced in
+	// all the namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// MaxCPUs is the maximum number of vCPUs (sockets * cores * threads) of a virtual machine
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxCPUs *uint32 `json:"maxCPUs,omitempty"`
+
+	// MaxMemory is the maximum guest memory of a virtual machine
+	// +optional
+	MaxMemory *resource.Quantity `json:"maxMemory,omitempty"`
+}
+
+// VMHostDevicesPolicy denies host devices, GPUs and hostDisk volumes that are not in the allowlist
+// +k8s:openapi-gen=true
+type VMHostDevicesPolicy struct {
+	// NamespaceSelector selects the namespaces where the policy is enforced. If not set, the policy is enforced in
+	// all the namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// AllowedDeviceNames is the list of the host device and GPU names (the deviceName field) that virtual machines
+	// may use. If empty, no host devices or GPUs are allowed.
+	// +listType=set
+	// +optional
+	AllowedDeviceNames []string `json:"allowedDeviceNames,omitempty"`
+
+	// AllowedHostDiskPaths is the list of the host directories that hostDisk volumes may use; a hostDisk path must be
+	// one of these directories, or under one of them, and it must not contain "..". If empty, no hostDisk volumes are
+	// allowed.
+	// +listType=set
+	// +optional
+	AllowedHostDiskPaths []string `json:"allowedHostDiskPaths,omitempty"`
+}
+
+// VMEvictionStrategyPolicy requires virtual machines to explicitly set their eviction strategy
+// +k8s:openapi-gen=true
+type VMEvictionStrategyPolicy struct {
+	// NamespaceSelector selects the namespaces where the policy is enforced. If not set, the policy is enforced in
+	// all the namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// AllowedStrategies is the list of the eviction strategies that virtual machines may use. If empty, any eviction
+	// strategy is allowed, as long as it is explicitly set.
+	// +listType=set
+	// +optional
+	AllowedStrategies []v1.EvictionStrategy `json:"allowedStrategies,omitempty"`
+}
+
+// VMNetworkBindingsPolicy limits the network interface bindings that virtual machines may use
+// +k8s:openapi-gen=true
+type VMNetworkBindingsPolicy struct {
+	// NamespaceSelector selects the namespaces where the policy is enforced. If not set, the policy is enforced in
+	// all the namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// AllowedBindings is the list of the network interface bindings that virtual machines may use; either a binding
+	// method (bridge, masquerade, sriov, macvtap or passt), or the name of a network binding plugin. Interfaces that
+	// do not explicitly set their binding are not checked.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	AllowedBindings []string `json:"allowedBindings"`
+}
+
+// ArchitectureConfiguration holds the virtual machine configurations of each CPU architecture
+// +k8s:openapi-gen=true
+type ArchitectureConfiguration struct {
+	// Amd64 is the configuration of the virtual machines on amd64 (x86_64) nodes
+	// +optional
+	Amd64 *ArchSpecificConfiguration `json:"amd64,omitempty"`
+
+	// Arm64 is the configuration of the virtual machines on arm64 (aarch64) nodes
+	// +optional
+	Arm64 *ArchSpecificConfiguration `json:"arm64,omitempty"`
+
+	// S390x is the configuration of the virtual machines on s390x nodes
+	// +optional
+	S390x *ArchSpecificConfiguration `json:"s390x,omitempty"`
+}
+
+// ArchSpecificConfiguration holds the virtual machine configuration of a CPU architecture
+// +k8s:openapi-gen=true
+type ArchSpecificConfiguration struct {
+	// MachineType is the default machine type of the virtual machines that do not set their machine type. The machine
+	// type must match one of the emulated machine types of the architecture.
+	// +optional
+	MachineType string `json:"machineType,omitempty"`
+
+	// OVMFPath is the path of the UEFI firmware files in the virt-launcher image
+	// +optional
+	OVMFPath string `json:"ovmfPath,omitempty"`
+
+	// EmulatedMachines is the list of the machine types that the virtual machines are allowed to use. Glob patterns,
+	// like "q35*", are supported.
+	// +optional
+	// +listType=set
+	EmulatedMachines []string `json:"emulatedMachines,omitempty"`
+}
+
 // KubeMacPoolConfig defines kubemacpool MAC address range configuration
 // +k8s:openapi-gen=true
 // +kubebuilder:validation:XValidation:rule="(has(self.rangeStart) && has(self.rangeEnd)) || (!has(self.rangeStart) && !has(self.rangeEnd))",message="both rangeStart and rangeEnd must be configured together, or both omitted"
+// +kubebuilder:validation:XValidation:rule="!has(self.ranges) || !has(self.rangeStart)",message="ranges cannot be configured together with rangeStart and rangeEnd"
 type KubeMacPoolConfig struct {
 	// RangeStart defines the first MAC address in the kubemacpool range.
 	// The MAC address format should be AA:BB:CC:DD:EE:FF.
@@ -901,6 +1465,241 @@ type KubeMacPoolConfig struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2}:){5}[0-9a-fA-F]{2}$`
 	RangeEnd *string `json:"rangeEnd,omitempty"`
+
+	// Ranges defines the MAC address ranges of kubemacpool, as an alternative to rangeStart and rangeEnd.
+	// The cluster-network-addons-operator supports only a single range for now, so a list with more than one range
+	// is rejected.
+	// +optional
+	// +listType=atomic
+	Ranges []MacAddressRange `json:"ranges,omitempty"`
+}
+
+// MacAddressRange defines a kubemacpool MAC address range
+// +k8s:openapi-gen=true
+type MacAddressRange struct {
+	// Start defines the first MAC address in the range.
+	// The MAC address format should be AA:BB:CC:DD:EE:FF.
+	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2}:){5}[0-9a-fA-F]{2}$`
+	Start string `json:"start"`
+
+	// End defines the last MAC address in the range.
+	// The MAC address format should be AA:BB:CC:DD:EE:FF.
+	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2}:){5}[0-9a-fA-F]{2}$`
+	End string `json:"end"`
+}
+
+// NetworkingConfig configures the network components that the cluster-network-addons-operator deploys
+// +k8s:openapi-gen=true
+type NetworkingConfig struct {
+	// Multus configures multus, that allows attaching multiple network interfaces to pods.
+	// Multus is deployed by default.
+	// +optional
+	Multus *NetworkComponentConfig `json:"multus,omitempty"`
+
+	// MultusDynamicNetworks configures the multus dynamic networks controller, that allows hot-plugging and
+	// hot-unplugging pod network interfaces. Requires multus. It is not deployed by default.
+	// +optional
+	MultusDynamicNetworks *NetworkComponentConfig `json:"multusDynamicNetworks,omitempty"`
+
+	// LinuxBridge configures the linux-bridge CNI plugin, that allows connecting pods to a linux bridge on the node.
+	// The linux-bridge CNI plugin is deployed by default.
+	// +optional
+	LinuxBridge *NetworkComponentConfig `json:"linuxBridge,omitempty"`
+
+	// Macvtap configures the macvtap CNI plugin, that allows connecting pods to the existing host interfaces.
+	// It is not deployed by default.
+	// +optional
+	Macvtap *MacvtapConfig `json:"macvtap,omitempty"`
+
+	// KubevirtIpamController configures the KubeVirt IPAM controller, that allows persistent IP addresses for the
+	// virtual machines that are connected to user defined networks. It is deployed by default.
+	// +optional
+	KubevirtIpamController *KubevirtIpamControllerConfig `json:"kubevirtIpamController,omitempty"`
+}
+
+// NetworkComponentConfig configures a network component
+// +k8s:openapi-gen=true
+type NetworkComponentConfig struct {
+	// Deploy controls whether the component is deployed. If not set, the default of the component is used.
+	// +optional
+	Deploy *bool `json:"deploy,omitempty"`
+}
+
+// MacvtapConfig configures the macvtap CNI plugin
+// +k8s:openapi-gen=true
+type MacvtapConfig struct {
+	NetworkComponentConfig `json:",inline"`
+
+	// DevicePluginConfig is the name of the ConfigMap that holds the macvtap device plugin configuration.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	DevicePluginConfig string `json:"devicePluginConfig,omitempty"`
+}
+
+// KubevirtIpamControllerConfig configures the KubeVirt IPAM controller
+// +k8s:openapi-gen=true
+type KubevirtIpamControllerConfig struct {
+	NetworkComponentConfig `json:",inline"`
+
+	// DefaultNetworkNADNamespace is the namespace of the NetworkAttachmentDefinition of the cluster default network.
+	// Defaults to "openshift-ovn-kubernetes" on OpenShift.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	DefaultNetworkNADNamespace string `json:"defaultNetworkNADNamespace,omitempty"`
+}
+
+// KubeSecondaryDNSConfig configures KubeSecondaryDNS
+// +k8s:openapi-gen=true
+type KubeSecondaryDNSConfig struct {
+	// Deploy controls whether KubeSecondaryDNS is deployed.
+	// +optional
+	Deploy *bool `json:"deploy,omitempty"`
+
+	// Domain is the DNS domain of the virtual machines' secondary network interfaces.
+	// Defaults to the cluster base domain.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	Domain string `json:"domain,omitempty"`
+
+	// NameServerIP is the IPv4 address of the name server of the domain, as published in its SOA record. This is
+	// usually the external address of KubeSecondaryDNS.
+	// +optional
+	NameServerIP string `json:"nameServerIP,omitempty"`
+
+	// Exposure configures the Service that exposes KubeSecondaryDNS outside the cluster, so the external DNS
+	// resolvers can delegate the domain to it. KubeSecondaryDNS is not exposed if this field is not set.
+	// +optional
+	Exposure *KubeSecondaryDNSExposure `json:"exposure,omitempty"`
+}
+
+// KubeSecondaryDNSExposure configures the Service that exposes KubeSecondaryDNS outside the cluster
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="!has(self.nodePort) || self.serviceType == 'NodePort'",message="nodePort can only be set when serviceType is NodePort"
+type KubeSecondaryDNSExposure struct {
+	// ServiceType is the type of the Service
+	// +kubebuilder:validation:Enum=LoadBalancer;NodePort
+	ServiceType corev1.ServiceType `json:"serviceType"`
+
+	// NodePort is the port that the Service exposes on the nodes, when ServiceType is NodePort. If not set, a port is
+	// allocated by the cluster.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	NodePort int32 `json:"nodePort,omitempty"`
+}
+
+// KubeSecondaryDNSStatus is the state of KubeSecondaryDNS
+type KubeSecondaryDNSStatus struct {
+	// Domain is the DNS domain that KubeSecondaryDNS serves
+	// +optional
+	Domain string `json:"domain,omitempty"`
+
+	// ExternalAddress is the IP address or host name of the load balancer that exposes KubeSecondaryDNS, when the
+	// exposure Service type is LoadBalancer. HCO does not report the node addresses for the NodePort Service type,
+	// where this field is empty: KubeSecondaryDNS is reachable on the externalPort of any of the nodes, and the
+	// external DNS resolvers should use the address of the nodes that they can reach.
+	// +optional
+	ExternalAddress string `json:"externalAddress,omitempty"`
+
+	// ExternalPort is the port that the external DNS resolvers should use: the load balancer port, or the UDP node
+	// port. When the cluster allocates the node ports, the TCP node port may be different; it is listed in the
+	// secondary-dns-external Service.
+	// +optional
+	ExternalPort int32 `json:"externalPort,omitempty"`
+}
+
+// NetworkPoliciesConfig extends the NetworkPolicies of the HyperConverged Cluster Operator pods. NetworkPolicies
+// are additive, so HCO deploys an additional NetworkPolicy for each of the fields that are set here.
+// +k8s:openapi-gen=true
+type NetworkPoliciesConfig struct {
+	// Disabled, if true, makes HCO deploy a NetworkPolicy that allows all the ingress and egress traffic of the pods
+	// that HCO deploys itself (the operator, the webhook, the CLI downloads, the console plugin and proxy, and the
+	// wasp agent), so the other NetworkPolicies of these pods have no effect. The operand pods, and any other pod in
+	// the namespace, are not affected. The HyperConverged NetworkPoliciesDisabled condition is set while this field
+	// is true.
+	// +kubebuilder:default=false
+	// +default=false
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// ExtraIngressPeers is a list of additional sources that are allowed to access the HCO pods that expose
+	// metrics.
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=atomic
+	// +optional
+	ExtraIngressPeers []networkingv1.NetworkPolicyPeer `json:"extraIngressPeers,omitempty"`
+
+	// ExtraEgressPeers is a list of additional destinations that the HCO pods that access the cluster services are
+	// allowed to access.
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=atomic
+	// +optional
+	ExtraEgressPeers []networkingv1.NetworkPolicyPeer `json:"extraEgressPeers,omitempty"`
+
+	// APIServer configures the address of the API server, for clusters where the API server is not reachable on
+	// the default port (6443), or where the egress traffic to it must be limited to specific addresses.
+	// +optional
+	APIServer *NetworkPoliciesAPIServer `json:"apiServer,omitempty"`
+
+	// PrometheusNamespace is the namespace of an additional Prometheus stack. If set, its pods are allowed to
+	// scrape the metrics of the HCO pods, and the operator is allowed to send alerts to it.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	PrometheusNamespace string `json:"prometheusNamespace,omitempty"`
+}
+
+// NetworkPoliciesAPIServer configures the egress traffic to the API server
+// +k8s:openapi-gen=true
+type NetworkPoliciesAPIServer struct {
+	// CIDRBlocks is the list of the IP address ranges of the API server endpoints, in CIDR notation. If not set, the
+	// API server ports are allowed to any address.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	// +optional
+	CIDRBlocks []string `json:"cidrBlocks,omitempty"`
+
+	// Ports is the list of the TCP ports of the API server endpoints. Defaults to 6443.
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=65535
+	// +listType=set
+	// +optional
+	Ports []int32 `json:"ports,omitempty"`
+}
+
+// BaselineAdminNetworkPolicyConfig configures the BaselineAdminNetworkPolicy of the virtual machine namespaces
+// +k8s:openapi-gen=true
+type BaselineAdminNetworkPolicyConfig struct {
+	// NamespaceSelector selects the namespaces that run virtual machines. It must not be empty.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+}
+
+// NetworkComponentName is the name of a network component, as used in spec.networking
+type NetworkComponentName string
+
+const (
+	NetworkComponentMultus                 NetworkComponentName = "multus"
+	NetworkComponentMultusDynamicNetworks  NetworkComponentName = "multusDynamicNetworks"
+	NetworkComponentLinuxBridge            NetworkComponentName = "linuxBridge"
+	NetworkComponentMacvtap                NetworkComponentName = "macvtap"
+	NetworkComponentKubevirtIpamController NetworkComponentName = "kubevirtIpamController"
+)
+
+// NetworkComponentStatus is the state of a network component
+type NetworkComponentStatus struct {
+	// Name is the name of the network component
+	Name NetworkComponentName `json:"name"`
+
+	// Ready is true if the component is deployed and ready
+	Ready bool `json:"ready"`
+
+	// Message describes why the component is not ready
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 const (
@@ -929,6 +1728,14 @@ const (
 	// has been applied to the HyperConverged resource via a specialized annotation.
 	// This condition is exposed only when its value is True, and is otherwise hidden.
 	ConditionTaintedConfiguration = "TaintedConfiguration"
+
+	// ConditionNetworkPoliciesDisabled indicates that the NetworkPolicies of the HCO pods are disabled by
+	// spec.networkPolicies.disabled. This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionNetworkPoliciesDisabled = "NetworkPoliciesDisabled"
+
+	// ConditionCertIssuerRefIgnored indicates that spec.certConfig.issuerRef can't be honored, and the webhook keeps
+	// using its default certificate. This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionCertIssuerRefIgnored = "CertIssuerRefIgnored"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -943,7 +1750,7 @@ type HyperConverged struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	// +kubebuilder:default={"certConfig": {"ca": {"duration": "48h0m0s", "renewBefore": "24h0m0s"}, "server": {"duration": "24h0m0s", "renewBefore": "12h0m0s"}},"featureGates": {"downwardMetrics": false, "deployKubeSecondaryDNS": false, "disableMDevConfiguration": false, "persistentReservation": false, "enableMultiArchBootImageImport": false, "decentralizedLiveMigration": false, "declarativeHotplugVolumes": false, "videoConfig": true, "objectGraph": false}, "liveMigrationConfig": {"completionTimeoutPerGiB": 150, "parallelMigrationsPerCluster": 5, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false}, "resourceRequirements": {"vmiCPUAllocationRatio": 10}, "uninstallStrategy": "BlockUninstallIfWorkloadsExist", "virtualMachineOptions": {"disableFreePageReporting": false, "disableSerialConsoleLog": false}, "enableApplicationAwareQuota": false, "enableCommonBootImageImport": true, "deployVmConsoleProxy": false}
+	// +kubebuilder:default={"certConfig": {"ca": {"duration": "48h0m0s", "renewBefore": "24h0m0s"}, "server": {"duration": "24h0m0s", "renewBefore": "12h0m0s"}},"featureGates": {"downwardMetrics": false, "disableMDevConfiguration": false, "persistentReservation": false, "enableMultiArchBootImageImport": false, "decentralizedLiveMigration": false, "declarativeHotplugVolumes": false, "videoConfig": true, "objectGraph": false}, "liveMigrationConfig": {"completionTimeoutPerGiB": 150, "parallelMigrationsPerCluster": 5, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false}, "resourceRequirements": {"vmiCPUAllocationRatio": 10}, "uninstallStrategy": "BlockUninstallIfWorkloadsExist", "virtualMachineOptions": {"disableFreePageReporting": false, "disableSerialConsoleLog": false}, "enableApplicationAwareQuota": false, "enableCommonBootImageImport": true, "deployVmConsoleProxy": false}
 	// +optional
 	Spec   HyperConvergedSpec   `json:"spec,omitempty"`
 	Status HyperConvergedStatus `json:"status,omitempty"`
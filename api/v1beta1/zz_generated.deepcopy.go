@@ -26,10 +26,11 @@ package v1beta1
 
 import (
 	configv1 "github.com/openshift/api/config/v1"
-	apicorev1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
-	corev1 "kubevirt.io/api/core/v1"
+	apicorev1 "kubevirt.io/api/core/v1"
 	v1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	corev1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
@@ -60,6 +61,107 @@ func (in *ApplicationAwareConfigurations) DeepCopy() *ApplicationAwareConfigurat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchSpecificConfiguration) DeepCopyInto(out *ArchSpecificConfiguration) {
+	*out = *in
+	if in.EmulatedMachines != nil {
+		in, out := &in.EmulatedMachines, &out.EmulatedMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchSpecificConfiguration.
+func (in *ArchSpecificConfiguration) DeepCopy() *ArchSpecificConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchSpecificConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitectureConfiguration) DeepCopyInto(out *ArchitectureConfiguration) {
+	*out = *in
+	if in.Amd64 != nil {
+		in, out := &in.Amd64, &out.Amd64
+		*out = new(ArchSpecificConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Arm64 != nil {
+		in, out := &in.Arm64, &out.Arm64
+		*out = new(ArchSpecificConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S390x != nil {
+		in, out := &in.S390x, &out.S390x
+		*out = new(ArchSpecificConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitectureConfiguration.
+func (in *ArchitectureConfiguration) DeepCopy() *ArchitectureConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitectureConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BaselineAdminNetworkPolicyConfig) DeepCopyInto(out *BaselineAdminNetworkPolicyConfig) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaselineAdminNetworkPolicyConfig.
+func (in *BaselineAdminNetworkPolicyConfig) DeepCopy() *BaselineAdminNetworkPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BaselineAdminNetworkPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockedVirtualMachine) DeepCopyInto(out *BlockedVirtualMachine) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockedVirtualMachine.
+func (in *BlockedVirtualMachine) DeepCopy() *BlockedVirtualMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockedVirtualMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertRotateConfigCA) DeepCopyInto(out *CertRotateConfigCA) {
 	*out = *in
@@ -112,6 +214,27 @@ func (in *CertRotateConfigServer) DeepCopy() *CertRotateConfigServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTLSSecurityProfile) DeepCopyInto(out *ComponentTLSSecurityProfile) {
+	*out = *in
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTLSSecurityProfile.
+func (in *ComponentTLSSecurityProfile) DeepCopy() *ComponentTLSSecurityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTLSSecurityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 	*out = *in
@@ -122,6 +245,10 @@ func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastImportTimestamp != nil {
+		in, out := &in.LastImportTimestamp, &out.LastImportTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -175,6 +302,44 @@ func (in *DataImportCronTemplateStatus) DeepCopy() *DataImportCronTemplateStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronTemplatesCatalogueStatus) DeepCopyInto(out *DataImportCronTemplatesCatalogueStatus) {
+	*out = *in
+	if in.DataImportCronTemplates != nil {
+		in, out := &in.DataImportCronTemplates, &out.DataImportCronTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronTemplatesCatalogueStatus.
+func (in *DataImportCronTemplatesCatalogueStatus) DeepCopy() *DataImportCronTemplatesCatalogueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronTemplatesCatalogueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportScheduleWindow) DeepCopyInto(out *DataImportScheduleWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportScheduleWindow.
+func (in *DataImportScheduleWindow) DeepCopy() *DataImportScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HigherWorkloadDensityConfiguration) DeepCopyInto(out *HigherWorkloadDensityConfiguration) {
 	*out = *in
@@ -224,6 +389,11 @@ func (in *HyperConvergedCertConfig) DeepCopyInto(out *HyperConvergedCertConfig)
 	*out = *in
 	in.CA.DeepCopyInto(&out.CA)
 	in.Server.DeepCopyInto(&out.Server)
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerReference)
+		**out = **in
+	}
 	return
 }
 
@@ -485,6 +655,11 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(StorageImportConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImportProxy != nil {
+		in, out := &in.ImportProxy, &out.ImportProxy
+		*out = new(corev1beta1.ImportProxy)
+		(*in).DeepCopyInto(*out)
+	}
 	in.WorkloadUpdateStrategy.DeepCopyInto(&out.WorkloadUpdateStrategy)
 	if in.DataImportCronTemplates != nil {
 		in, out := &in.DataImportCronTemplates, &out.DataImportCronTemplates
@@ -508,6 +683,11 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(configv1.TLSSecurityProfile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.TektonPipelinesNamespace != nil {
 		in, out := &in.TektonPipelinesNamespace, &out.TektonPipelinesNamespace
 		*out = new(string)
@@ -523,14 +703,34 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KubeSecondaryDNS != nil {
+		in, out := &in.KubeSecondaryDNS, &out.KubeSecondaryDNS
+		*out = new(KubeSecondaryDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KubeMacPoolConfiguration != nil {
 		in, out := &in.KubeMacPoolConfiguration, &out.KubeMacPoolConfiguration
 		*out = new(KubeMacPoolConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(NetworkingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(NetworkPoliciesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BaselineAdminNetworkPolicy != nil {
+		in, out := &in.BaselineAdminNetworkPolicy, &out.BaselineAdminNetworkPolicy
+		*out = new(BaselineAdminNetworkPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EvictionStrategy != nil {
 		in, out := &in.EvictionStrategy, &out.EvictionStrategy
-		*out = new(corev1.EvictionStrategy)
+		*out = new(apicorev1.EvictionStrategy)
 		**out = **in
 	}
 	if in.VMStateStorageClass != nil {
@@ -550,16 +750,21 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	}
 	if in.KSMConfiguration != nil {
 		in, out := &in.KSMConfiguration, &out.KSMConfiguration
-		*out = new(corev1.KSMConfiguration)
+		*out = new(apicorev1.KSMConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.NetworkBinding != nil {
 		in, out := &in.NetworkBinding, &out.NetworkBinding
-		*out = make(map[string]corev1.InterfaceBindingPlugin, len(*in))
+		*out = make(map[string]apicorev1.InterfaceBindingPlugin, len(*in))
 		for key, val := range *in {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.NetworkBindingPlugins != nil {
+		in, out := &in.NetworkBindingPlugins, &out.NetworkBindingPlugins
+		*out = make([]NetworkBindingPlugin, len(*in))
+		copy(*out, *in)
+	}
 	if in.ApplicationAwareConfig != nil {
 		in, out := &in.ApplicationAwareConfig, &out.ApplicationAwareConfig
 		*out = new(ApplicationAwareConfigurations)
@@ -575,14 +780,19 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DataImportScheduleWindow != nil {
+		in, out := &in.DataImportScheduleWindow, &out.DataImportScheduleWindow
+		*out = new(DataImportScheduleWindow)
+		**out = **in
+	}
 	if in.InstancetypeConfig != nil {
 		in, out := &in.InstancetypeConfig, &out.InstancetypeConfig
-		*out = new(corev1.InstancetypeConfiguration)
+		*out = new(apicorev1.InstancetypeConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CommonInstancetypesDeployment != nil {
 		in, out := &in.CommonInstancetypesDeployment, &out.CommonInstancetypesDeployment
-		*out = new(corev1.CommonInstancetypesDeployment)
+		*out = new(apicorev1.CommonInstancetypesDeployment)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.DeployVMConsoleProxy != nil {
@@ -597,7 +807,29 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	}
 	if in.LiveUpdateConfiguration != nil {
 		in, out := &in.LiveUpdateConfiguration, &out.LiveUpdateConfiguration
-		*out = new(corev1.LiveUpdateConfiguration)
+		*out = new(apicorev1.LiveUpdateConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VMGovernancePolicies != nil {
+		in, out := &in.VMGovernancePolicies, &out.VMGovernancePolicies
+		*out = new(VMGovernancePolicies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualMachineDefaults != nil {
+		in, out := &in.VirtualMachineDefaults, &out.VirtualMachineDefaults
+		*out = make([]VirtualMachineDefaults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProtectOperandCRs != nil {
+		in, out := &in.ProtectOperandCRs, &out.ProtectOperandCRs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ArchitectureConfiguration != nil {
+		in, out := &in.ArchitectureConfiguration, &out.ArchitectureConfiguration
+		*out = new(ArchitectureConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -625,7 +857,7 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 	}
 	if in.RelatedObjects != nil {
 		in, out := &in.RelatedObjects, &out.RelatedObjects
-		*out = make([]apicorev1.ObjectReference, len(*in))
+		*out = make([]corev1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.Versions != nil {
@@ -640,12 +872,59 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DataImportCronTemplatesCatalogue != nil {
+		in, out := &in.DataImportCronTemplatesCatalogue, &out.DataImportCronTemplatesCatalogue
+		*out = new(DataImportCronTemplatesCatalogueStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InfrastructureHighlyAvailable != nil {
 		in, out := &in.InfrastructureHighlyAvailable, &out.InfrastructureHighlyAvailable
 		*out = new(bool)
 		**out = **in
 	}
 	in.NodeInfo.DeepCopyInto(&out.NodeInfo)
+	if in.WorkloadUpdate != nil {
+		in, out := &in.WorkloadUpdate, &out.WorkloadUpdate
+		*out = new(WorkloadUpdateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualMachineDefaults != nil {
+		in, out := &in.VirtualMachineDefaults, &out.VirtualMachineDefaults
+		*out = make([]VirtualMachineDefaultsStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLSSecurityProfiles != nil {
+		in, out := &in.TLSSecurityProfiles, &out.TLSSecurityProfiles
+		*out = make([]ComponentTLSSecurityProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkBindingPlugins != nil {
+		in, out := &in.NetworkBindingPlugins, &out.NetworkBindingPlugins
+		*out = make([]NetworkBindingPluginStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = make([]NetworkComponentStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeSecondaryDNS != nil {
+		in, out := &in.KubeSecondaryDNS, &out.KubeSecondaryDNS
+		*out = new(KubeSecondaryDNSStatus)
+		**out = **in
+	}
+	if in.ImportProxy != nil {
+		in, out := &in.ImportProxy, &out.ImportProxy
+		*out = new(corev1beta1.ImportProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperandImages != nil {
+		in, out := &in.OperandImages, &out.OperandImages
+		*out = make([]OperandImage, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -677,6 +956,11 @@ func (in *HyperConvergedWorkloadUpdateStrategy) DeepCopyInto(out *HyperConverged
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.UpdateStallThreshold != nil {
+		in, out := &in.UpdateStallThreshold, &out.UpdateStallThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -703,6 +987,11 @@ func (in *KubeMacPoolConfig) DeepCopyInto(out *KubeMacPoolConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]MacAddressRange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -716,6 +1005,81 @@ func (in *KubeMacPoolConfig) DeepCopy() *KubeMacPoolConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSecondaryDNSConfig) DeepCopyInto(out *KubeSecondaryDNSConfig) {
+	*out = *in
+	if in.Deploy != nil {
+		in, out := &in.Deploy, &out.Deploy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Exposure != nil {
+		in, out := &in.Exposure, &out.Exposure
+		*out = new(KubeSecondaryDNSExposure)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSecondaryDNSConfig.
+func (in *KubeSecondaryDNSConfig) DeepCopy() *KubeSecondaryDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSecondaryDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSecondaryDNSExposure) DeepCopyInto(out *KubeSecondaryDNSExposure) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSecondaryDNSExposure.
+func (in *KubeSecondaryDNSExposure) DeepCopy() *KubeSecondaryDNSExposure {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSecondaryDNSExposure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSecondaryDNSStatus) DeepCopyInto(out *KubeSecondaryDNSStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSecondaryDNSStatus.
+func (in *KubeSecondaryDNSStatus) DeepCopy() *KubeSecondaryDNSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSecondaryDNSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubevirtIpamControllerConfig) DeepCopyInto(out *KubevirtIpamControllerConfig) {
+	*out = *in
+	in.NetworkComponentConfig.DeepCopyInto(&out.NetworkComponentConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubevirtIpamControllerConfig.
+func (in *KubevirtIpamControllerConfig) DeepCopy() *KubevirtIpamControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubevirtIpamControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LiveMigrationConfigurations) DeepCopyInto(out *LiveMigrationConfigurations) {
 	*out = *in
@@ -777,7 +1141,7 @@ func (in *LogVerbosityConfiguration) DeepCopyInto(out *LogVerbosityConfiguration
 	*out = *in
 	if in.Kubevirt != nil {
 		in, out := &in.Kubevirt, &out.Kubevirt
-		*out = new(corev1.LogVerbosity)
+		*out = new(apicorev1.LogVerbosity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CDI != nil {
@@ -798,6 +1162,39 @@ func (in *LogVerbosityConfiguration) DeepCopy() *LogVerbosityConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacAddressRange) DeepCopyInto(out *MacAddressRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacAddressRange.
+func (in *MacAddressRange) DeepCopy() *MacAddressRange {
+	if in == nil {
+		return nil
+	}
+	out := new(MacAddressRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacvtapConfig) DeepCopyInto(out *MacvtapConfig) {
+	*out = *in
+	in.NetworkComponentConfig.DeepCopyInto(&out.NetworkComponentConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacvtapConfig.
+func (in *MacvtapConfig) DeepCopy() *MacvtapConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MacvtapConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -848,12 +1245,188 @@ func (in *MediatedHostDevice) DeepCopy() *MediatedHostDevice {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeInfoStatus) DeepCopyInto(out *NodeInfoStatus) {
+func (in *NetworkBindingPlugin) DeepCopyInto(out *NetworkBindingPlugin) {
 	*out = *in
-	if in.WorkloadsArchitectures != nil {
-		in, out := &in.WorkloadsArchitectures, &out.WorkloadsArchitectures
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkBindingPlugin.
+func (in *NetworkBindingPlugin) DeepCopy() *NetworkBindingPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBindingPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkBindingPluginStatus) DeepCopyInto(out *NetworkBindingPluginStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkBindingPluginStatus.
+func (in *NetworkBindingPluginStatus) DeepCopy() *NetworkBindingPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBindingPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkComponentConfig) DeepCopyInto(out *NetworkComponentConfig) {
+	*out = *in
+	if in.Deploy != nil {
+		in, out := &in.Deploy, &out.Deploy
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkComponentConfig.
+func (in *NetworkComponentConfig) DeepCopy() *NetworkComponentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkComponentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkComponentStatus) DeepCopyInto(out *NetworkComponentStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkComponentStatus.
+func (in *NetworkComponentStatus) DeepCopy() *NetworkComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPoliciesAPIServer) DeepCopyInto(out *NetworkPoliciesAPIServer) {
+	*out = *in
+	if in.CIDRBlocks != nil {
+		in, out := &in.CIDRBlocks, &out.CIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoliciesAPIServer.
+func (in *NetworkPoliciesAPIServer) DeepCopy() *NetworkPoliciesAPIServer {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPoliciesAPIServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPoliciesConfig) DeepCopyInto(out *NetworkPoliciesConfig) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtraIngressPeers != nil {
+		in, out := &in.ExtraIngressPeers, &out.ExtraIngressPeers
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraEgressPeers != nil {
+		in, out := &in.ExtraEgressPeers, &out.ExtraEgressPeers
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = new(NetworkPoliciesAPIServer)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoliciesConfig.
+func (in *NetworkPoliciesConfig) DeepCopy() *NetworkPoliciesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPoliciesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkingConfig) DeepCopyInto(out *NetworkingConfig) {
+	*out = *in
+	if in.Multus != nil {
+		in, out := &in.Multus, &out.Multus
+		*out = new(NetworkComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MultusDynamicNetworks != nil {
+		in, out := &in.MultusDynamicNetworks, &out.MultusDynamicNetworks
+		*out = new(NetworkComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinuxBridge != nil {
+		in, out := &in.LinuxBridge, &out.LinuxBridge
+		*out = new(NetworkComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Macvtap != nil {
+		in, out := &in.Macvtap, &out.Macvtap
+		*out = new(MacvtapConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubevirtIpamController != nil {
+		in, out := &in.KubevirtIpamController, &out.KubevirtIpamController
+		*out = new(KubevirtIpamControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingConfig.
+func (in *NetworkingConfig) DeepCopy() *NetworkingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeInfoStatus) DeepCopyInto(out *NodeInfoStatus) {
+	*out = *in
+	if in.WorkloadsArchitectures != nil {
+		in, out := &in.WorkloadsArchitectures, &out.WorkloadsArchitectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	if in.ControlPlaneArchitectures != nil {
 		in, out := &in.ControlPlaneArchitectures, &out.ControlPlaneArchitectures
@@ -906,12 +1479,28 @@ func (in *NodeMediatedDeviceTypesConfig) DeepCopy() *NodeMediatedDeviceTypesConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandImage) DeepCopyInto(out *OperandImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandImage.
+func (in *OperandImage) DeepCopy() *OperandImage {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperandResourceRequirements) DeepCopyInto(out *OperandResourceRequirements) {
 	*out = *in
 	if in.StorageWorkloads != nil {
 		in, out := &in.StorageWorkloads, &out.StorageWorkloads
-		*out = new(apicorev1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.VmiCPUAllocationRatio != nil {
@@ -986,6 +1575,22 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageImportConfig) DeepCopyInto(out *StorageImportConfig) {
 	*out = *in
@@ -994,6 +1599,11 @@ func (in *StorageImportConfig) DeepCopyInto(out *StorageImportConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]RegistryMirror, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1044,6 +1654,156 @@ func (in *USBSelector) DeepCopy() *USBSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMEvictionStrategyPolicy) DeepCopyInto(out *VMEvictionStrategyPolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedStrategies != nil {
+		in, out := &in.AllowedStrategies, &out.AllowedStrategies
+		*out = make([]apicorev1.EvictionStrategy, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMEvictionStrategyPolicy.
+func (in *VMEvictionStrategyPolicy) DeepCopy() *VMEvictionStrategyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VMEvictionStrategyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMGovernancePolicies) DeepCopyInto(out *VMGovernancePolicies) {
+	*out = *in
+	if in.ResourceLimits != nil {
+		in, out := &in.ResourceLimits, &out.ResourceLimits
+		*out = new(VMResourceLimitsPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostDevices != nil {
+		in, out := &in.HostDevices, &out.HostDevices
+		*out = new(VMHostDevicesPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvictionStrategy != nil {
+		in, out := &in.EvictionStrategy, &out.EvictionStrategy
+		*out = new(VMEvictionStrategyPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkBindings != nil {
+		in, out := &in.NetworkBindings, &out.NetworkBindings
+		*out = new(VMNetworkBindingsPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMGovernancePolicies.
+func (in *VMGovernancePolicies) DeepCopy() *VMGovernancePolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(VMGovernancePolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMHostDevicesPolicy) DeepCopyInto(out *VMHostDevicesPolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedDeviceNames != nil {
+		in, out := &in.AllowedDeviceNames, &out.AllowedDeviceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedHostDiskPaths != nil {
+		in, out := &in.AllowedHostDiskPaths, &out.AllowedHostDiskPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMHostDevicesPolicy.
+func (in *VMHostDevicesPolicy) DeepCopy() *VMHostDevicesPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VMHostDevicesPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMNetworkBindingsPolicy) DeepCopyInto(out *VMNetworkBindingsPolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedBindings != nil {
+		in, out := &in.AllowedBindings, &out.AllowedBindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMNetworkBindingsPolicy.
+func (in *VMNetworkBindingsPolicy) DeepCopy() *VMNetworkBindingsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VMNetworkBindingsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMResourceLimitsPolicy) DeepCopyInto(out *VMResourceLimitsPolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxCPUs != nil {
+		in, out := &in.MaxCPUs, &out.MaxCPUs
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxMemory != nil {
+		in, out := &in.MaxMemory, &out.MaxMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMResourceLimitsPolicy.
+func (in *VMResourceLimitsPolicy) DeepCopy() *VMResourceLimitsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VMResourceLimitsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Version) DeepCopyInto(out *Version) {
 	*out = *in
@@ -1060,6 +1820,81 @@ func (in *Version) DeepCopy() *Version {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDefaultPreference) DeepCopyInto(out *VirtualMachineDefaultPreference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDefaultPreference.
+func (in *VirtualMachineDefaultPreference) DeepCopy() *VirtualMachineDefaultPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDefaultPreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDefaults) DeepCopyInto(out *VirtualMachineDefaults) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(VirtualMachineDefaultPreference)
+		**out = **in
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NetworkInterfaceBinding != nil {
+		in, out := &in.NetworkInterfaceBinding, &out.NetworkInterfaceBinding
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDefaults.
+func (in *VirtualMachineDefaults) DeepCopy() *VirtualMachineDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDefaultsStatus) DeepCopyInto(out *VirtualMachineDefaultsStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDefaultsStatus.
+func (in *VirtualMachineDefaultsStatus) DeepCopy() *VirtualMachineDefaultsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDefaultsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineOptions) DeepCopyInto(out *VirtualMachineOptions) {
 	*out = *in
@@ -1085,3 +1920,28 @@ func (in *VirtualMachineOptions) DeepCopy() *VirtualMachineOptions {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUpdateStatus) DeepCopyInto(out *WorkloadUpdateStatus) {
+	*out = *in
+	if in.BlockedVirtualMachines != nil {
+		in, out := &in.BlockedVirtualMachines, &out.BlockedVirtualMachines
+		*out = make([]BlockedVirtualMachine, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastProgressTime != nil {
+		in, out := &in.LastProgressTime, &out.LastProgressTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUpdateStatus.
+func (in *WorkloadUpdateStatus) DeepCopy() *WorkloadUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -44,10 +44,6 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 		var ptrVar1 bool = false
 		in.Spec.FeatureGates.DownwardMetrics = &ptrVar1
 	}
-	if in.Spec.FeatureGates.DeployKubeSecondaryDNS == nil {
-		var ptrVar1 bool = false
-		in.Spec.FeatureGates.DeployKubeSecondaryDNS = &ptrVar1
-	}
 	if in.Spec.FeatureGates.DisableMDevConfiguration == nil {
 		var ptrVar1 bool = false
 		in.Spec.FeatureGates.DisableMDevConfiguration = &ptrVar1
@@ -124,6 +120,14 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 			panic(err)
 		}
 	}
+	if in.Spec.CertConfig.IssuerRef != nil {
+		if in.Spec.CertConfig.IssuerRef.Kind == "" {
+			in.Spec.CertConfig.IssuerRef.Kind = "Issuer"
+		}
+		if in.Spec.CertConfig.IssuerRef.Group == "" {
+			in.Spec.CertConfig.IssuerRef.Group = "cert-manager.io"
+		}
+	}
 	if in.Spec.ResourceRequirements != nil {
 		if in.Spec.ResourceRequirements.VmiCPUAllocationRatio == nil {
 			var ptrVar1 int = 10
@@ -144,9 +148,20 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 			panic(err)
 		}
 	}
+	if in.Spec.WorkloadUpdateStrategy.UpdateStallThreshold == nil {
+		if err := json.Unmarshal([]byte(`"1h0m0s"`), &in.Spec.WorkloadUpdateStrategy.UpdateStallThreshold); err != nil {
+			panic(err)
+		}
+	}
 	if in.Spec.UninstallStrategy == "" {
 		in.Spec.UninstallStrategy = "BlockUninstallIfWorkloadsExist"
 	}
+	if in.Spec.NetworkPolicies != nil {
+		if in.Spec.NetworkPolicies.Disabled == nil {
+			var ptrVar1 bool = false
+			in.Spec.NetworkPolicies.Disabled = &ptrVar1
+		}
+	}
 	if in.Spec.VirtualMachineOptions == nil {
 		if err := json.Unmarshal([]byte(`{"disableFreePageReporting": false, "disableSerialConsoleLog": false}`), &in.Spec.VirtualMachineOptions); err != nil {
 			panic(err)
@@ -184,6 +199,18 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 		var ptrVar1 bool = false
 		in.Spec.EnableApplicationAwareQuota = &ptrVar1
 	}
+	for i := range in.Spec.VirtualMachineDefaults {
+		a := &in.Spec.VirtualMachineDefaults[i]
+		if a.Preference != nil {
+			if a.Preference.Kind == "" {
+				a.Preference.Kind = "VirtualMachineClusterPreference"
+			}
+		}
+	}
+	if in.Spec.ProtectOperandCRs == nil {
+		var ptrVar1 bool = false
+		in.Spec.ProtectOperandCRs = &ptrVar1
+	}
 }
 
 func SetObjectDefaults_HyperConvergedList(in *HyperConvergedList) {
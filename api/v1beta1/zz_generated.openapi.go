@@ -32,8 +32,13 @@ import (
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations":       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ApplicationAwareConfigurations(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchSpecificConfiguration":            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ArchSpecificConfiguration(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchitectureConfiguration":            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ArchitectureConfiguration(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.BaselineAdminNetworkPolicyConfig":     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_BaselineAdminNetworkPolicyConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertManagerIssuerReference":           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertManagerIssuerReference(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigCA(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigServer(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportScheduleWindow":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_DataImportScheduleWindow(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConverged":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedCertConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates":           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedFeatureGates(ref),
@@ -42,17 +47,36 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedStatus":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedStatus(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy": schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedWorkloadUpdateStrategy(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeMacPoolConfig":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_KubeMacPoolConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeSecondaryDNSConfig":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_KubeSecondaryDNSConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeSecondaryDNSExposure":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_KubeSecondaryDNSExposure(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubevirtIpamControllerConfig":         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_KubevirtIpamControllerConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations":          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationConfigurations(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration":            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LogVerbosityConfiguration(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MacAddressRange":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MacAddressRange(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MacvtapConfig":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MacvtapConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration":         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedDevicesConfiguration(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedHostDevice":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedHostDevice(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkBindingPlugin":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkBindingPlugin(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkComponentConfig":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkComponentConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkPoliciesAPIServer":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkPoliciesAPIServer(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkPoliciesConfig":                schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkPoliciesConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkingConfig":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkingConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeMediatedDeviceTypesConfig":        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements":          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandResourceRequirements(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PciHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PciHostDevice(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDevices(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.RegistryMirror":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_RegistryMirror(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.USBHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBHostDevice(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.USBSelector":                          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBSelector(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMEvictionStrategyPolicy":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMEvictionStrategyPolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMGovernancePolicies":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMGovernancePolicies(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMHostDevicesPolicy":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMHostDevicesPolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMNetworkBindingsPolicy":              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMNetworkBindingsPolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMResourceLimitsPolicy":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMResourceLimitsPolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaultPreference":      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VirtualMachineDefaultPreference(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaults":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VirtualMachineDefaults(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaultsStatus":         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VirtualMachineDefaultsStatus(ref),
 	}
 }
 
@@ -91,6 +115,147 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ApplicationAwar
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ArchSpecificConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArchSpecificConfiguration holds the virtual machine configuration of a CPU architecture",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the default machine type of the virtual machines that do not set their machine type. The machine type must match one of the emulated machine types of the architecture.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ovmfPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OVMFPath is the path of the UEFI firmware files in the virt-launcher image",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"emulatedMachines": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "EmulatedMachines is the list of the machine types that the virtual machines are allowed to use. Glob patterns, like \"q35*\", are supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ArchitectureConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArchitectureConfiguration holds the virtual machine configurations of each CPU architecture",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"amd64": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Amd64 is the configuration of the virtual machines on amd64 (x86_64) nodes",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchSpecificConfiguration"),
+						},
+					},
+					"arm64": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arm64 is the configuration of the virtual machines on arm64 (aarch64) nodes",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchSpecificConfiguration"),
+						},
+					},
+					"s390x": {
+						SchemaProps: spec.SchemaProps{
+							Description: "S390x is the configuration of the virtual machines on s390x nodes",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchSpecificConfiguration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchSpecificConfiguration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_BaselineAdminNetworkPolicyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BaselineAdminNetworkPolicyConfig configures the BaselineAdminNetworkPolicy of the virtual machine namespaces",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces that run virtual machines. It must not be empty.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"namespaceSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertManagerIssuerReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CertManagerIssuerReference is a reference to a cert-manager issuer",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the issuer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the issuer; Issuer, in the HCO namespace, or ClusterIssuer",
+							Default:     "Issuer",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group of the issuer. Only needed for external issuers, that are not part of cert-manager",
+							Default:     "cert-manager.io",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigCA(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -149,6 +314,36 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfi
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_DataImportScheduleWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataImportScheduleWindow defines a daily time window, for importing the common golden images",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the time of the day when the window opens, in the \"HH:MM\" format, in UTC.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the window. Must be at least one minute, and no longer than 24 hours. This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"start", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -217,11 +412,17 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedC
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer"),
 						},
 					},
+					"issuerRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IssuerRef is a reference to a cert-manager Issuer or ClusterIssuer. When set, and cert-manager is installed in the cluster, HCO requests the serving certificate of its webhook from this issuer, using the server duration and renewBefore, instead of using the self-signed certificate. The operands keep using their internal self-signed certificates, as none of them supports an external CA. If cert-manager is not installed, or HCO is deployed by OLM, this field is ignored, and the CertIssuerRefIgnored condition is set.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertManagerIssuerReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertManagerIssuerReference", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer"},
 	}
 }
 
@@ -270,8 +471,7 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedF
 					},
 					"deployKubeSecondaryDNS": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deploy KubeSecondaryDNS by CNAO",
-							Default:     false,
+							Description: "Deprecated: This field is ignored and will be removed on the next version of the API. Use spec.kubeSecondaryDNS.deploy instead",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -549,6 +749,12 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig"),
 						},
 					},
+					"importProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportProxy configures the proxy of the CDI importer pods. On OpenShift, the importer pods use the cluster-wide proxy of the Proxy CR by default; ImportProxy overrides this configuration. On other clusters, the importer pods only use a proxy if ImportProxy is set. The effective configuration is reported in status.importProxy.",
+							Ref:         ref("kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportProxy"),
+						},
+					},
 					"workloadUpdateStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "WorkloadUpdateStrategy defines at the cluster level how to handle automated workload updates",
@@ -597,10 +803,29 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 					},
 					"tlsSecurityProfile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLSSecurityProfile specifies the settings for TLS connections to be propagated to all kubevirt-hyperconverged components. If unset, the hyperconverged cluster operator will consume the value set on the APIServer CR on OCP/OKD or Intermediate if on vanilla k8s. Note that only Old, Intermediate and Custom profiles are currently supported, and the maximum available MinTLSVersions is VersionTLS12.",
+							Description: "TLSSecurityProfile specifies the settings for TLS connections to be propagated to all kubevirt-hyperconverged components. If unset, the hyperconverged cluster operator will consume the value set on the APIServer CR on OCP/OKD or Intermediate if on vanilla k8s. The Old, Intermediate, Modern and Custom profiles are supported. The Modern profile, or a Custom profile with VersionTLS13 as the minTLSVersion, restricts all the components to TLS 1.3.",
 							Ref:         ref("github.com/openshift/api/config/v1.TLSSecurityProfile"),
 						},
 					},
+					"imagePullSecrets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets is a list of Secrets in the HyperConverged namespace, to pull the container images from private registries. The Secrets are set in the ServiceAccounts, and in the pods of the Deployments and the DaemonSets that HCO deploys, and passed to KubeVirt and to CDI. The other image pull secrets of the ServiceAccounts, e.g. the ones that OpenShift injects, are kept.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
 					"tektonPipelinesNamespace": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TektonPipelinesNamespace defines namespace in which example pipelines will be deployed. If unset, then the default value is the operator namespace. Deprecated: This field is ignored.",
@@ -617,17 +842,41 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 					},
 					"kubeSecondaryDNSNameServerIP": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeSecondaryDNSNameServerIP defines name server IP used by KubeSecondaryDNS",
+							Description: "KubeSecondaryDNSNameServerIP defines name server IP used by KubeSecondaryDNS Deprecated: use spec.kubeSecondaryDNS.nameServerIP instead. This field is only used if spec.kubeSecondaryDNS.nameServerIP is not set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"kubeSecondaryDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeSecondaryDNS configures KubeSecondaryDNS, the DNS server for the secondary network interfaces of the virtual machines. KubeSecondaryDNS is deployed by the cluster-network-addons-operator.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeSecondaryDNSConfig"),
+						},
+					},
 					"kubeMacPoolConfiguration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "KubeMacPoolConfiguration holds kubemacpool MAC address range configuration.",
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeMacPoolConfig"),
 						},
 					},
+					"networking": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networking configures the network components that the cluster-network-addons-operator deploys. The state of each deployed component is reported in status.networking.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkingConfig"),
+						},
+					},
+					"networkPolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicies extends the NetworkPolicies that restrict the network traffic of the HyperConverged Cluster Operator pods, for clusters where these policies block legitimate traffic; e.g. clusters with a custom API server endpoint, an egress proxy or an additional monitoring stack.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkPoliciesConfig"),
+						},
+					},
+					"baselineAdminNetworkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaselineAdminNetworkPolicy makes HCO deploy the cluster BaselineAdminNetworkPolicy, for the namespaces that run virtual machines. The policy allows the traffic that KubeVirt needs, and denies any other traffic of the pods in these namespaces that is not allowed by a NetworkPolicy. The policy is only deployed if the BaselineAdminNetworkPolicy API (policy.networking.k8s.io/v1alpha1) is installed in the cluster.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.BaselineAdminNetworkPolicyConfig"),
+						},
+					},
 					"evictionStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "EvictionStrategy defines at the cluster level if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific field is set it overrides the cluster level one. Allowed values: - `None` no eviction strategy at cluster level. - `LiveMigrate` migrate the VM on eviction; a not live migratable VM with no specific strategy will block the drain of the node util manually evicted. - `LiveMigrateIfPossible` migrate the VM on eviction if live migration is possible, otherwise directly evict. - `External` block the drain, track eviction and notify an external controller. Defaults to LiveMigrate with multiple worker nodes, None on single worker clusters.",
@@ -664,7 +913,7 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 					},
 					"networkBinding": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NetworkBinding defines the network binding plugins. Those bindings can be used when defining virtual machine interfaces.",
+							Description: "NetworkBinding defines the network binding plugins. Those bindings can be used when defining virtual machine interfaces. A binding with the binding name of an enabled managed network binding plugin is ignored; the binding of the plugin is used instead.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -677,6 +926,28 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							},
 						},
 					},
+					"networkBindingPlugins": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkBindingPlugins is the list of the network binding plugins that HCO deploys and registers in KubeVirt. HCO deploys all the components that each plugin requires (e.g. the CNI plugin DaemonSet and the NetworkAttachmentDefinition), and adds the plugin to the KubeVirt network bindings. The state of each plugin is reported in status.networkBindingPlugins.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkBindingPlugin"),
+									},
+								},
+							},
+						},
+					},
 					"applicationAwareConfig": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ApplicationAwareConfig set the AAQ configurations",
@@ -698,6 +969,12 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Format:      "",
 						},
 					},
+					"dataImportScheduleWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataImportScheduleWindow is the daily time window for importing the common golden images. If set, HCO spreads the import schedules of the common DataImportCronTemplates within this window, and each common golden image is imported once a day. If not set, HCO uses the randomly generated status.dataImportSchedule for all the common DataImportCronTemplates.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportScheduleWindow"),
+						},
+					},
 					"instancetypeConfig": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InstancetypeConfig holds the configuration of instance type related functionality within KubeVirt.",
@@ -732,11 +1009,53 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("kubevirt.io/api/core/v1.LiveUpdateConfiguration"),
 						},
 					},
+					"vmGovernancePolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMGovernancePolicies enables a set of ValidatingAdmissionPolicies, shipped by HCO, that enforce common virtual machine governance rules. Each policy is only enforced if it is set.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMGovernancePolicies"),
+						},
+					},
+					"virtualMachineDefaults": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineDefaults is a list of cluster-wide defaults for new virtual machines. HCO applies each item with a MutatingAdmissionPolicy, to the virtual machines that are created in the namespaces that are selected by the item. The defaults only set the fields that are not already set in the virtual machine. This field requires the MutatingAdmissionPolicy API (admissionregistration.k8s.io/v1beta1) to be enabled in the cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaults"),
+									},
+								},
+							},
+						},
+					},
+					"protectOperandCRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProtectOperandCRs if true, HCO deploys a ValidatingAdmissionPolicy that denies direct modifications of the spec of the operand CRs that are owned by HCO (e.g. the KubeVirt and the CDI CRs), unless they are done by HCO itself. These CRs should be configured using the HyperConverged CR fields, or the jsonpatch annotations.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"architectureConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArchitectureConfiguration sets the default machine type, the UEFI firmware path and the allowed emulated machine types of the virtual machines, per CPU architecture. A field that is not set here, keeps the default value of the architecture, that is set by the operator deployment.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchitectureConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeMacPoolConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineOptions", "github.com/openshift/api/config/v1.TLSSecurityProfile", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchitectureConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.BaselineAdminNetworkPolicyConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportScheduleWindow", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeMacPoolConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeSecondaryDNSConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkBindingPlugin", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkPoliciesConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkingConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMGovernancePolicies", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaults", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineOptions", "github.com/openshift/api/config/v1.TLSSecurityProfile", "k8s.io/api/core/v1.LocalObjectReference", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportProxy"},
 	}
 }
 
@@ -815,7 +1134,7 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 					},
 					"dataImportSchedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataImportSchedule is the cron expression that is used in for the hard-coded data import cron templates. HCO generates the value of this field once and stored in the status field, so will survive restart.",
+							Description: "DataImportSchedule is the cron expression that is used in for the hard-coded data import cron templates. HCO generates the value of this field once and stored in the status field, so will survive restart. This schedule is not in use if the spec.dataImportScheduleWindow field is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -834,6 +1153,12 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							},
 						},
 					},
+					"dataImportCronTemplatesCatalogue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataImportCronTemplatesCatalogue describes the external catalogue of the common DataImportCronTemplates, if exists. The field is empty if there is no external catalogue.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplatesCatalogueStatus"),
+						},
+					},
 					"systemHealthStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "SystemHealthStatus reflects the health of HCO and its secondary resources, based on the aggregated conditions.",
@@ -855,43 +1180,171 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeInfoStatus"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeInfoStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
-	}
-}
-
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedWorkloadUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"workloadUpdateMethods": {
+					"workloadUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkloadUpdate summarizes the progress of the automated workload update, after a KubeVirt upgrade. The field is empty if there is no VirtualMachineInstance running with an outdated virt-launcher.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.WorkloadUpdateStatus"),
+						},
+					},
+					"virtualMachineDefaults": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads during automated workload updates. When multiple methods are present, the least disruptive method takes precedence over more disruptive methods. For example if both LiveMigrate and Evict methods are listed, only VMs which are not live migratable will be restarted/shutdown. An empty list defaults to no automated workload updating.",
-							Default:     []interface{}{"LiveMigrate"},
+							Description: "VirtualMachineDefaults reports which of the spec.virtualMachineDefaults items are active",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaultsStatus"),
 									},
 								},
 							},
 						},
 					},
-					"batchEvictionSize": {
+					"tlsSecurityProfiles": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"component",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TLSSecurityProfiles reports the effective TLS security profile of each component",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentTLSSecurityProfile"),
+									},
+								},
+							},
+						},
+					},
+					"networkBindingPlugins": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkBindingPlugins reports the state of each of the spec.networkBindingPlugins",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkBindingPluginStatus"),
+									},
+								},
+							},
+						},
+					},
+					"networking": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Networking reports the state of each of the network components that are deployed according to spec.networking",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkComponentStatus"),
+									},
+								},
+							},
+						},
+					},
+					"kubeSecondaryDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeSecondaryDNS reports the domain that KubeSecondaryDNS serves, and the address that the external DNS resolvers can delegate this domain to",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeSecondaryDNSStatus"),
+						},
+					},
+					"importProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportProxy reports the effective proxy configuration of the CDI importer pods: spec.importProxy, or the cluster-wide proxy. The credentials in the proxy URLs are masked.",
+							Ref:         ref("kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportProxy"),
+						},
+					},
+					"operandImages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OperandImages lists the container images that HCO deploys, or passes to the operands, as configured in the environment of the HyperConverged Cluster Operator deployment, and the images of the operators of the operands (KubeVirt, CDI, CNAO, SSP, AAQ and the migration controller), as set in their deployments, with their digests. The images that the operators deploy for their operands are derived from the operator images, and are reported by the operands.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandImage"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentTLSSecurityProfile", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplatesCatalogueStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeSecondaryDNSStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkBindingPluginStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkComponentStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeInfoStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandImage", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaultsStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.WorkloadUpdateStatus", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportProxy"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedWorkloadUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workloadUpdateMethods": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads during automated workload updates. When multiple methods are present, the least disruptive method takes precedence over more disruptive methods. For example if both LiveMigrate and Evict methods are listed, only VMs which are not live migratable will be restarted/shutdown. An empty list defaults to no automated workload updating.",
+							Default:     []interface{}{"LiveMigrate"},
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"batchEvictionSize": {
 						SchemaProps: spec.SchemaProps{
 							Description: "BatchEvictionSize Represents the number of VMIs that can be forced updated per the BatchShutdownInterval interval",
 							Default:     10,
@@ -906,6 +1359,13 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedW
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"updateStallThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStallThreshold is the time that the workload update may run without any progress, before it is considered as stalled. A stalled workload update is reported by the kubevirt_hco_workload_update_stalled metric, and raises the HCOWorkloadUpdateStalled alert.",
+							Default:     "1h0m0s",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"workloadUpdateMethods"},
 			},
@@ -936,6 +1396,125 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_KubeMacPoolConf
 							Format:      "",
 						},
 					},
+					"ranges": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ranges defines the MAC address ranges of kubemacpool, as an alternative to rangeStart and rangeEnd. The cluster-network-addons-operator supports only a single range for now, so a list with more than one range is rejected.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MacAddressRange"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MacAddressRange"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_KubeSecondaryDNSConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeSecondaryDNSConfig configures KubeSecondaryDNS",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deploy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deploy controls whether KubeSecondaryDNS is deployed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"domain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domain is the DNS domain of the virtual machines' secondary network interfaces. Defaults to the cluster base domain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nameServerIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NameServerIP is the IPv4 address of the name server of the domain, as published in its SOA record. This is usually the external address of KubeSecondaryDNS.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exposure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exposure configures the Service that exposes KubeSecondaryDNS outside the cluster, so the external DNS resolvers can delegate the domain to it. KubeSecondaryDNS is not exposed if this field is not set.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeSecondaryDNSExposure"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubeSecondaryDNSExposure"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_KubeSecondaryDNSExposure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeSecondaryDNSExposure configures the Service that exposes KubeSecondaryDNS outside the cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serviceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceType is the type of the Service",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodePort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodePort is the port that the Service exposes on the nodes, when ServiceType is NodePort. If not set, a port is allocated by the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"serviceType"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_KubevirtIpamControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubevirtIpamControllerConfig configures the KubeVirt IPAM controller",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deploy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deploy controls whether the component is deployed. If not set, the default of the component is used.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"defaultNetworkNADNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultNetworkNADNamespace is the namespace of the NetworkAttachmentDefinition of the cluster default network. Defaults to \"openshift-ovn-kubernetes\" on OpenShift.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1045,6 +1624,63 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LogVerbosityCon
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MacAddressRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MacAddressRange defines a kubemacpool MAC address range",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start defines the first MAC address in the range. The MAC address format should be AA:BB:CC:DD:EE:FF.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End defines the last MAC address in the range. The MAC address format should be AA:BB:CC:DD:EE:FF.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MacvtapConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MacvtapConfig configures the macvtap CNI plugin",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deploy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deploy controls whether the component is deployed. If not set, the default of the component is used.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"devicePluginConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DevicePluginConfig is the name of the ConfigMap that holds the macvtap device plugin configuration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedDevicesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1161,23 +1797,237 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedHostDev
 	}
 }
 
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkBindingPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NodeMediatedDeviceTypesConfig holds information about MDEV types to be defined in a specific node that matches the NodeSelector field.",
+				Description: "NetworkBindingPlugin is a network binding plugin that HCO deploys",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodeSelector": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node's labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
+							Description: "Name is the name of the network binding plugin",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkComponentConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkComponentConfig configures a network component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deploy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deploy controls whether the component is deployed. If not set, the default of the component is used.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkPoliciesAPIServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPoliciesAPIServer configures the egress traffic to the API server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidrBlocks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRBlocks is the list of the IP address ranges of the API server endpoints, in CIDR notation. If not set, the API server ports are allowed to any address.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports is the list of the TCP ports of the API server endpoints. Defaults to 6443.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkPoliciesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPoliciesConfig extends the NetworkPolicies of the HyperConverged Cluster Operator pods. NetworkPolicies are additive, so HCO deploys an additional NetworkPolicy for each of the fields that are set here.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled, if true, makes HCO deploy a NetworkPolicy that allows all the ingress and egress traffic of the pods that HCO deploys itself (the operator, the webhook, the CLI downloads, the console plugin and proxy, and the wasp agent), so the other NetworkPolicies of these pods have no effect. The operand pods, and any other pod in the namespace, are not affected. The HyperConverged NetworkPoliciesDisabled condition is set while this field is true.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"extraIngressPeers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtraIngressPeers is a list of additional sources that are allowed to access the HCO pods that expose metrics.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/networking/v1.NetworkPolicyPeer"),
+									},
+								},
+							},
+						},
+					},
+					"extraEgressPeers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtraEgressPeers is a list of additional destinations that the HCO pods that access the cluster services are allowed to access.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/networking/v1.NetworkPolicyPeer"),
+									},
+								},
+							},
+						},
+					},
+					"apiServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIServer configures the address of the API server, for clusters where the API server is not reachable on the default port (6443), or where the egress traffic to it must be limited to specific addresses.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkPoliciesAPIServer"),
+						},
+					},
+					"prometheusNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusNamespace is the namespace of an additional Prometheus stack. If set, its pods are allowed to scrape the metrics of the HCO pods, and the operator is allowed to send alerts to it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkPoliciesAPIServer", "k8s.io/api/networking/v1.NetworkPolicyPeer"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NetworkingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkingConfig configures the network components that the cluster-network-addons-operator deploys",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"multus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multus configures multus, that allows attaching multiple network interfaces to pods. Multus is deployed by default.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkComponentConfig"),
+						},
+					},
+					"multusDynamicNetworks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MultusDynamicNetworks configures the multus dynamic networks controller, that allows hot-plugging and hot-unplugging pod network interfaces. Requires multus. It is not deployed by default.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkComponentConfig"),
+						},
+					},
+					"linuxBridge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LinuxBridge configures the linux-bridge CNI plugin, that allows connecting pods to a linux bridge on the node. The linux-bridge CNI plugin is deployed by default.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkComponentConfig"),
+						},
+					},
+					"macvtap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Macvtap configures the macvtap CNI plugin, that allows connecting pods to the existing host interfaces. It is not deployed by default.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MacvtapConfig"),
+						},
+					},
+					"kubevirtIpamController": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubevirtIpamController configures the KubeVirt IPAM controller, that allows persistent IP addresses for the virtual machines that are connected to user defined networks. It is deployed by default.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubevirtIpamControllerConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.KubevirtIpamControllerConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MacvtapConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NetworkComponentConfig"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeMediatedDeviceTypesConfig holds information about MDEV types to be defined in a specific node that matches the NodeSelector field.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node's labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
 										Format:  "",
 									},
 								},
@@ -1387,6 +2237,36 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDe
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_RegistryMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryMirror defines a mirror for a registry, or for a repository in a registry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is the registry host, optionally followed by a repository path, to be replaced by the mirror; e.g. \"quay.io\", or \"quay.io/containerdisks\". If more than one source matches an image, the longest one is used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mirror": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirror is the registry host, optionally followed by a repository path, to pull the images from; e.g. \"mirror.example.com:5000/containerdisks\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"source", "mirror"},
+			},
+		},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1414,9 +2294,33 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportCo
 							},
 						},
 					},
+					"registryMirrors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"source",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryMirrors is a list of mirrors for the registries of the common golden images. HCO rewrites the source URL of each common DataImportCronTemplate that matches one of the sources, to pull the image from the mirror instead. This is useful for disconnected clusters, that can't reach the original registries. A rewritten DataImportCronTemplate is not considered as modified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.RegistryMirror"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.RegistryMirror"},
 	}
 }
 
@@ -1503,3 +2407,360 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBSelector(ref
 		},
 	}
 }
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMEvictionStrategyPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMEvictionStrategyPolicy requires virtual machines to explicitly set their eviction strategy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces where the policy is enforced. If not set, the policy is enforced in all the namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"allowedStrategies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedStrategies is the list of the eviction strategies that virtual machines may use. If empty, any eviction strategy is allowed, as long as it is explicitly set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMGovernancePolicies(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMGovernancePolicies holds the configuration of the virtual machine governance policies. The policies validate the creation of virtual machines, and the updates of their spec.template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceLimits limits the number of vCPUs and the amount of memory of each virtual machine",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMResourceLimitsPolicy"),
+						},
+					},
+					"hostDevices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostDevices denies host devices, GPUs and hostDisk volumes that are not in the allowlist",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMHostDevicesPolicy"),
+						},
+					},
+					"evictionStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionStrategy requires virtual machines to explicitly set their eviction strategy",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMEvictionStrategyPolicy"),
+						},
+					},
+					"networkBindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkBindings limits the network interface bindings that virtual machines may use",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMNetworkBindingsPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMEvictionStrategyPolicy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMHostDevicesPolicy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMNetworkBindingsPolicy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VMResourceLimitsPolicy"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMHostDevicesPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMHostDevicesPolicy denies host devices, GPUs and hostDisk volumes that are not in the allowlist",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces where the policy is enforced. If not set, the policy is enforced in all the namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"allowedDeviceNames": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedDeviceNames is the list of the host device and GPU names (the deviceName field) that virtual machines may use. If empty, no host devices or GPUs are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allowedHostDiskPaths": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedHostDiskPaths is the list of the host directories that hostDisk volumes may use; a hostDisk path must be one of these directories, or under one of them, and it must not contain \"..\". If empty, no hostDisk volumes are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMNetworkBindingsPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMNetworkBindingsPolicy limits the network interface bindings that virtual machines may use",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces where the policy is enforced. If not set, the policy is enforced in all the namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"allowedBindings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedBindings is the list of the network interface bindings that virtual machines may use; either a binding method (bridge, masquerade, sriov, macvtap or passt), or the name of a network binding plugin. Interfaces that do not explicitly set their binding are not checked.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"allowedBindings"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VMResourceLimitsPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMResourceLimitsPolicy limits the number of vCPUs and the amount of memory of each virtual machine. Resources that are set by an instance type are not checked.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces where the policy is enforced. If not set, the policy is enforced in all the namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"maxCPUs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxCPUs is the maximum number of vCPUs (sockets * cores * threads) of a virtual machine",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxMemory is the maximum guest memory of a virtual machine",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VirtualMachineDefaultPreference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineDefaultPreference is the default preference of virtual machines",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the preference",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the preference",
+							Default:     "VirtualMachineClusterPreference",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VirtualMachineDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineDefaults defines default values for new virtual machines, in the selected namespaces",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of this item. It is used in the names of the MutatingAdmissionPolicy and its binding.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces where the defaults are applied. If not set, the defaults are applied in all the namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"preference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preference is the default preference of virtual machines that don't set a preference",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaultPreference"),
+						},
+					},
+					"terminationGracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationGracePeriodSeconds is the default grace period of the virtual machine shutdown, in seconds",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"networkInterfaceBinding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaceBinding is the binding of the pod network interface; either a binding method (bridge or masquerade), or the name of a network binding plugin. It is only applied to virtual machines with no interfaces and no networks, that do not disable the automatic attachment of the pod interface. HCO then adds an explicit \"default\" pod network interface with this binding.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the virtual machine and to its VMI template, if they are not already set; e.g. for cost tracking.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineDefaultPreference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_VirtualMachineDefaultsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineDefaultsStatus reports the state of the MutatingAdmissionPolicy of a spec.virtualMachineDefaults item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the spec.virtualMachineDefaults item",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PolicyName is the name of the MutatingAdmissionPolicy that applies the defaults",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Active is true if the MutatingAdmissionPolicy and its binding are deployed",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains why the defaults are not active",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "active"},
+			},
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package cmdcommon
+
+import (
+	"crypto/tls"
+	"path/filepath"
+
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// NewCertWatcher returns a certificate watcher for the serving certificate in certDir. The watcher reloads the
+// certificate and the key when the files are modified, so a rotated certificate is used without restarting the pod,
+// and it exports the expiration time of the certificate as a metric, named by certificateName.
+//
+// The watcher should be added to the manager, and passed to the servers using WithCertWatcher.
+func (h HcCmdHelper) NewCertWatcher(certDir, certificateName string) (*certwatcher.CertWatcher, error) {
+	certPath := filepath.Join(certDir, hcoutil.WebhookCertName)
+	keyPath := filepath.Join(certDir, hcoutil.WebhookKeyName)
+
+	cw, err := certwatcher.New(certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cw.RegisterCallback(func(cert tls.Certificate) {
+		expiration, err := hcoutil.GetKeyPairExpiration(cert)
+		if err != nil {
+			h.Logger.Error(err, "can't read the expiration time of the certificate", "path", certPath)
+			return
+		}
+
+		h.Logger.Info("the serving certificate was loaded", "path", certPath, "expiration", expiration)
+		metrics.SetCertificateExpiration(certificateName, expiration)
+	})
+
+	return cw, nil
+}
+
+// WithCertWatcher sets the server to always use the current certificate of the watcher
+func WithCertWatcher(cw *certwatcher.CertWatcher) func(*tls.Config) {
+	return func(cfg *tls.Config) {
+		cfg.GetCertificate = cw.GetCertificate
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/webhooks/validator"
@@ -52,6 +53,10 @@ func NewHelper(logger logr.Logger, name string) *HcCmdHelper {
 func (h HcCmdHelper) InitiateCommand() {
 	zapFlagSet := flag.NewFlagSet("zap", flag.ExitOnError)
 
+	metricsAuthMode := pflag.String("metrics-auth-mode", authorization.MetricsAuthModeBearerToken,
+		fmt.Sprintf("the authorization mode of the metrics endpoint; one of %q, %q",
+			authorization.MetricsAuthModeBearerToken, authorization.MetricsAuthModeKubernetes))
+
 	updateFlagSet(flag.CommandLine, zapFlagSet)
 	pflag.Parse()
 
@@ -60,6 +65,9 @@ func (h HcCmdHelper) InitiateCommand() {
 
 	h.printVersion()
 
+	h.ExitOnError(authorization.SetMetricsAuthMode(*metricsAuthMode), "invalid metrics authorization mode")
+	h.Logger.Info("Metrics endpoint authorization", "mode", authorization.GetMetricsAuthMode())
+
 	h.checkNameSpace()
 }
 
@@ -187,7 +195,11 @@ func MutateTLSConfig(cfg *tls.Config) {
 	cfg.GetConfigForClient = func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
 		cipherNames, minTypedTLSVersion := validator.SelectCipherSuitesAndMinTLSVersion()
 
-		cfg.CipherSuites = crypto.CipherSuitesOrDie(crypto.OpenSSLToIANACipherSuites(cipherNames))
+		// with TLS 1.3 only (e.g. the Modern profile), there are no ciphers to configure
+		cfg.CipherSuites = nil
+		if len(cipherNames) > 0 {
+			cfg.CipherSuites = crypto.CipherSuitesOrDie(crypto.OpenSSLToIANACipherSuites(cipherNames))
+		}
 		cfg.MinVersion = crypto.TLSVersionOrDie(string(minTypedTLSVersion))
 		return cfg, nil
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	persesv1alpha1 "github.com/rhobs/perses-operator/api/v1alpha1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -33,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/wait"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
@@ -97,6 +99,7 @@ var (
 		sspv1beta3.AddToScheme,
 		csvv1alpha1.AddToScheme,
 		admissionregistrationv1.AddToScheme,
+		admissionregistrationv1beta1.AddToScheme,
 		consolev1.Install,
 		consolev1.Install,
 		operatorv1.Install,
@@ -213,8 +216,11 @@ func main() {
 	nodeEventChannel := make(chan event.GenericEvent, 10)
 	defer close(nodeEventChannel)
 
+	admissionPolicyEventCh := make(chan event.GenericEvent, 10)
+	defer close(admissionPolicyEventCh)
+
 	// Create a new reconciler
-	if err = hyperconverged.RegisterReconciler(mgr, ci, upgradeableCondition, ingressEventCh, nodeEventChannel); err != nil {
+	if err = hyperconverged.RegisterReconciler(mgr, ci, upgradeableCondition, ingressEventCh, nodeEventChannel, admissionPolicyEventCh); err != nil {
 		logger.Error(err, "failed to register the HyperConverged controller")
 		eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to register HyperConverged controller; "+err.Error())
 		os.Exit(1)
@@ -270,7 +276,7 @@ func main() {
 		}
 	}
 
-	if err = admissionpolicy.RegisterReconciler(ctx, mgr); err != nil {
+	if err = admissionpolicy.RegisterReconciler(ctx, mgr, admissionPolicyEventCh); err != nil {
 		logger.Error(err, "failed to register the admission policy controller")
 		eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to register admission policy controller; "+err.Error())
 		os.Exit(1)
@@ -284,7 +290,7 @@ func main() {
 	err = metrics.SetupMetrics()
 	cmdHelper.ExitOnError(err, "failed to setup metrics: %v")
 
-	err = collectors.SetupCollectors(mgr.GetClient(), operatorNamespace)
+	err = collectors.SetupCollectors(mgr.GetClient(), mgr.GetAPIReader(), operatorNamespace)
 	cmdHelper.ExitOnError(err, "failed to setup metrics controllers: %v")
 
 	err = passt.CheckPasstImagesEnvExists()
@@ -321,6 +327,22 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 	namespaceSelector := fields.Set{"metadata.namespace": operatorNamespace}.AsSelector()
 	labelSelector := labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}.AsSelector()
 	labelSelectorForNamespace := labels.Set{hcoutil.KubernetesMetadataName: operatorNamespace}.AsSelector()
+	outdatedVMIRequirement, _ := labels.NewRequirement(kubevirtcorev1.OutdatedLauncherImageLabel, selection.Exists, nil)
+	dataImportCronRequirement, _ := labels.NewRequirement(hcoutil.DataImportCronLabel, selection.Exists, nil)
+
+	configMapCacheOptions := cache.ByObject{
+		Label: labelSelector,
+	}
+	if ci.IsOpenshift() {
+		// also cache the user provided ConfigMaps of the openshift-config namespace, to watch the trusted CA ConfigMap
+		// of the cluster-wide proxy
+		configMapCacheOptions = cache.ByObject{
+			Namespaces: map[string]cache.Config{
+				cache.AllNamespaces:              {LabelSelector: labelSelector},
+				hcoutil.OpenshiftConfigNamespace: {},
+			},
+		}
+	}
 
 	cacheOptions := cache.Options{
 		ByObject: map[client.Object]cache.ByObject{
@@ -334,8 +356,11 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 			&schedulingv1.PriorityClass{}: {
 				Label: labels.SelectorFromSet(labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}),
 			},
-			&corev1.ConfigMap{}: {
+			&corev1.ConfigMap{}: configMapCacheOptions,
+			// only the HCO pods, to read the digests of the images that they run
+			&corev1.Pod{}: {
 				Label: labelSelector,
+				Field: namespaceSelector,
 			},
 			&corev1.Service{}: {
 				Field: namespaceSelector,
@@ -369,6 +394,10 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 			&corev1.Namespace{}: {
 				Label: labelSelectorForNamespace,
 			},
+			&networkingv1.NetworkPolicy{}: {
+				Label: labelSelector,
+				Field: namespaceSelector,
+			},
 			&appsv1.Deployment{}: {
 				Label: labelSelector,
 				Field: namespaceSelector,
@@ -380,6 +409,10 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 			&admissionregistrationv1.ValidatingAdmissionPolicyBinding{}: {
 				Label: labels.SelectorFromSet(labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}),
 			},
+			// only the VMIs that are still running with an outdated virt-launcher, to track the workload update
+			&kubevirtcorev1.VirtualMachineInstance{}: {
+				Label: labels.NewSelector().Add(*outdatedVMIRequirement),
+			},
 		},
 	}
 
@@ -392,14 +425,6 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 			Label: labelSelector,
 			Field: namespaceSelector,
 		},
-		&networkingv1.NetworkPolicy{}: {
-			Label: labelSelector,
-			Field: namespaceSelector,
-		},
-		&corev1.Secret{}: {
-			Label: labelSelector,
-			Field: namespaceSelector,
-		},
 	}
 
 	// Perses resources (namespaced): restrict cache to operator namespace, only when CRDs are available
@@ -427,6 +452,7 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 			Label: labelSelector,
 		},
 		&openshiftconfigv1.APIServer{}: {},
+		&openshiftconfigv1.Proxy{}:     {},
 		&consolev1.ConsoleCLIDownload{}: {
 			Label: labelSelector,
 		},
@@ -439,6 +465,14 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 		&securityv1.SecurityContextConstraints{}: {
 			Label: labelSelector,
 		},
+		// HCO only reads the status of the DataImportCrons that SSP creates, and of the DataSources that these
+		// DataImportCrons manage. They may be in any namespace, so they are filtered by their labels.
+		&cdiv1beta1.DataImportCron{}: {
+			Label: labels.SelectorFromSet(labels.Set{hcoutil.AppLabelManagedBy: hcoutil.SSPOperatorName}),
+		},
+		&cdiv1beta1.DataSource{}: {
+			Label: labels.NewSelector().Add(*dataImportCronRequirement),
+		},
 	}
 
 	cacheOptionsByObjectForNetwork := map[client.Object]cache.ByObject{
@@ -447,9 +481,25 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 		},
 	}
 
+	cacheOptionsByObjectForMutatingAdmissionPolicy := map[client.Object]cache.ByObject{
+		&admissionregistrationv1beta1.MutatingAdmissionPolicy{}: {
+			Label: labels.SelectorFromSet(labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}),
+		},
+		&admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{}: {
+			Label: labels.SelectorFromSet(labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}),
+		},
+	}
+
 	if ci.IsMonitoringAvailable() {
 		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForMonitoring)
 	}
+	// the Secrets of the metrics authorization, and the Secret of the webhook certificate that cert-manager issues
+	if ci.IsMonitoringAvailable() || ci.IsCertManagerAvailable() {
+		cacheOptions.ByObject[&corev1.Secret{}] = cache.ByObject{
+			Label: labelSelector,
+			Field: namespaceSelector,
+		}
+	}
 	if ci.IsDeschedulerAvailable() {
 		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForDescheduler)
 	}
@@ -461,6 +511,10 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForNetwork)
 	}
 
+	if ci.IsMutatingAdmissionPolicyAvailable() {
+		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForMutatingAdmissionPolicy)
+	}
+
 	return cacheOptions
 }
 
@@ -469,7 +523,7 @@ func getManagerOptions(operatorNamespace string, needLeaderElection bool, ci hco
 		Metrics: server.Options{
 			SecureServing:  true,
 			BindAddress:    fmt.Sprintf("%s:%d", hcoutil.MetricsHost, hcoutil.MetricsPort),
-			FilterProvider: authorization.HttpWithBearerToken,
+			FilterProvider: authorization.GetMetricsFilterProvider(),
 			TLSOpts:        []func(*tls.Config){cmdcommon.MutateTLSConfig},
 		},
 		HealthProbeBindAddress: fmt.Sprintf("%s:%d", hcoutil.HealthProbeHost, hcoutil.HealthProbePort),
@@ -10,6 +10,7 @@ import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -27,12 +28,15 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+	controllerruntimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	migrationv1alpha1 "kubevirt.io/kubevirt-migration-operator/api/v1alpha1"
 	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api"
@@ -41,6 +45,7 @@ import (
 	whapiservercontrollers "github.com/kubevirt/hyperconverged-cluster-operator/controllers/webhooks/apiserver-controller"
 	bearertokencontroller "github.com/kubevirt/hyperconverged-cluster-operator/controllers/webhooks/bearer-token-controller"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/webhooks"
@@ -64,6 +69,8 @@ var (
 		csvv1alpha1.AddToScheme,
 		apiextensionsv1.AddToScheme,
 		monitoringv1.AddToScheme,
+		aaqv1alpha1.AddToScheme,
+		migrationv1alpha1.AddToScheme,
 	}
 )
 
@@ -107,16 +114,18 @@ func main() {
 	err = cmdcommon.ClusterInitializations(ctx, apiClient, scheme, logger)
 	cmdHelper.ExitOnError(err, "Cannot detect cluster type")
 
+	// the webhook server and the metrics server share the same certificate, and reload it when it is rotated
+	certWatcher, err := cmdHelper.NewCertWatcher(webhookCertDir, metrics.CertificateWebhook)
+	cmdHelper.ExitOnError(err, "Cannot load the webhook certificate")
+	tlsOpts := []func(*tls.Config){cmdcommon.MutateTLSConfig, cmdcommon.WithCertWatcher(certWatcher)}
+
 	// Create a new Cmd to provide shared dependencies and start components
 	mgr, err := manager.New(cfg, manager.Options{
 		Metrics: server.Options{
 			SecureServing:  true,
-			CertDir:        webhooks.GetWebhookCertDir(),
-			CertName:       hcoutil.WebhookCertName,
-			KeyName:        hcoutil.WebhookKeyName,
 			BindAddress:    fmt.Sprintf("%s:%d", hcoutil.MetricsHost, hcoutil.MetricsPort),
-			FilterProvider: authorization.HttpWithBearerToken,
-			TLSOpts:        []func(*tls.Config){cmdcommon.MutateTLSConfig},
+			FilterProvider: authorization.GetMetricsFilterProvider(),
+			TLSOpts:        tlsOpts,
 		},
 		HealthProbeBindAddress:     fmt.Sprintf("%s:%d", hcoutil.HealthProbeHost, hcoutil.HealthProbePort),
 		ReadinessEndpointName:      hcoutil.ReadinessEndpointName,
@@ -127,11 +136,8 @@ func main() {
 		Scheme:                     scheme,
 
 		WebhookServer: webhook.NewServer(webhook.Options{
-			CertDir:  webhooks.GetWebhookCertDir(),
-			CertName: hcoutil.WebhookCertName,
-			KeyName:  hcoutil.WebhookKeyName,
-			Port:     hcoutil.WebhookPort,
-			TLSOpts:  []func(*tls.Config){cmdcommon.MutateTLSConfig},
+			Port:    hcoutil.WebhookPort,
+			TLSOpts: tlsOpts,
 		}),
 		Cache: getCacheOption(operatorNamespace, hcoutil.GetClusterInfo()),
 	})
@@ -147,6 +153,9 @@ func main() {
 	eventEmitter := hcoutil.GetEventEmitter()
 	eventEmitter.Init(ownresources.GetPod(), ownresources.GetCSVRef(), mgr.GetEventRecorderFor(hcoutil.HyperConvergedName))
 
+	err = mgr.Add(certWatcher)
+	cmdHelper.ExitOnError(err, "unable to add the certificate watcher")
+
 	err = mgr.AddHealthzCheck("ping", healthz.Ping)
 	cmdHelper.ExitOnError(err, "unable to add health check")
 
@@ -169,6 +178,11 @@ func main() {
 	err = whapiservercontrollers.RegisterReconciler(mgr, ci)
 	cmdHelper.ExitOnError(err, "Cannot register APIServer reconciler")
 
+	// the webhook only exposes the bearer token and the certificate metrics
+	operatormetrics.Register = controllerruntimemetrics.Registry.Register
+	err = metrics.SetupWebhookMetrics()
+	cmdHelper.ExitOnError(err, "failed to setup the webhook metrics")
+
 	logger.Info("Registering the Bearer Token reconciler")
 	err = bearertokencontroller.RegisterReconciler(mgr, ci, eventEmitter)
 	cmdHelper.ExitOnError(err, "Cannot register the Bearer Token reconciler")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
 	"slices"
 
@@ -11,18 +12,23 @@ import (
 	"github.com/google/uuid"
 	operatorhandler "github.com/operator-framework/operator-lib/handler"
 	admissionv1 "k8s.io/api/admissionregistration/v1"
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -38,10 +44,18 @@ var (
 			Name: "startup-req-" + randomConstSuffix,
 		},
 	}
+
+	// hyperConvergedReq is queued when the admission policies configuration in the HyperConverged CR is modified
+	hyperConvergedReq = reconcile.Request{
+		NamespacedName: k8stypes.NamespacedName{
+			Name: "hyperconverged-req-" + randomConstSuffix,
+		},
+	}
 )
 
-// RegisterReconciler creates a new Nodes Reconciler and registers it into manager.
-func RegisterReconciler(ctx context.Context, mgr manager.Manager) error {
+// RegisterReconciler creates a new Nodes Reconciler and registers it into manager. An event is sent to hcEvents when
+// the status of the VM defaults policies is changed, so the HyperConverged controller updates the HyperConverged status.
+func RegisterReconciler(ctx context.Context, mgr manager.Manager, hcEvents chan<- event.GenericEvent) error {
 	startupEvent := make(chan event.GenericEvent, 1)
 	defer close(startupEvent)
 
@@ -50,7 +64,7 @@ func RegisterReconciler(ctx context.Context, mgr manager.Manager) error {
 		return err
 	}
 
-	r := newReconciler(mgr, startupEvent, ownerRef)
+	r := newReconciler(mgr, startupEvent, ownerRef, hcEvents)
 
 	startupEvent <- event.GenericEvent{}
 
@@ -58,13 +72,15 @@ func RegisterReconciler(ctx context.Context, mgr manager.Manager) error {
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager, startupEvent <-chan event.GenericEvent, ownerRef *metav1.OwnerReference) *ReconcileAdmissionPolicy {
+func newReconciler(mgr manager.Manager, startupEvent <-chan event.GenericEvent, ownerRef *metav1.OwnerReference, hcEvents chan<- event.GenericEvent) *ReconcileAdmissionPolicy {
 	initLogger.Info("Initializing the admission policy controller")
 
 	r := &ReconcileAdmissionPolicy{
 		Client:       mgr.GetClient(),
 		owner:        ownerRef,
 		startupEvent: startupEvent,
+		hcEvents:     hcEvents,
+		mapAvailable: hcoutil.GetClusterInfo().IsMutatingAdmissionPolicyAvailable(),
 	}
 
 	return r
@@ -100,6 +116,54 @@ func add(mgr manager.Manager, r *ReconcileAdmissionPolicy) error {
 		return err
 	}
 
+	// Watch for changes to the params ConfigMaps of the VM governance policies
+	if err = c.Watch(
+		source.Kind[*corev1.ConfigMap](
+			mgr.GetCache(), &corev1.ConfigMap{},
+			&handler.TypedEnqueueRequestForObject[*corev1.ConfigMap]{},
+			paramsPredicate,
+		),
+	); err != nil {
+		return err
+	}
+
+	if r.mapAvailable {
+		// Watch for changes to the MutatingAdmissionPolicies of the VM defaults
+		if err = c.Watch(
+			source.Kind[*admissionv1beta1.MutatingAdmissionPolicy](
+				mgr.GetCache(), &admissionv1beta1.MutatingAdmissionPolicy{},
+				&handler.TypedEnqueueRequestForObject[*admissionv1beta1.MutatingAdmissionPolicy]{},
+				mutatingPolicyPredicate,
+			),
+		); err != nil {
+			return err
+		}
+
+		// Watch for changes to the MutatingAdmissionPolicyBindings of the VM defaults
+		if err = c.Watch(
+			source.Kind[*admissionv1beta1.MutatingAdmissionPolicyBinding](
+				mgr.GetCache(), &admissionv1beta1.MutatingAdmissionPolicyBinding{},
+				&handler.TypedEnqueueRequestForObject[*admissionv1beta1.MutatingAdmissionPolicyBinding]{},
+				mutatingBindingPredicate,
+			),
+		); err != nil {
+			return err
+		}
+	}
+
+	// Watch for changes to the admission policies configuration in the HyperConverged CR
+	if err = c.Watch(
+		source.Kind[*hcov1beta1.HyperConverged](
+			mgr.GetCache(), &hcov1beta1.HyperConverged{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, _ *hcov1beta1.HyperConverged) []reconcile.Request {
+				return []reconcile.Request{hyperConvergedReq}
+			}),
+			predicate.TypedGenerationChangedPredicate[*hcov1beta1.HyperConverged]{},
+		),
+	); err != nil {
+		return err
+	}
+
 	return c.Watch(
 		source.Channel(
 			r.startupEvent,
@@ -116,6 +180,10 @@ type ReconcileAdmissionPolicy struct {
 	client.Client
 	owner        *metav1.OwnerReference
 	startupEvent <-chan event.GenericEvent
+	// hcEvents triggers the HyperConverged controller, to update the HyperConverged status
+	hcEvents chan<- event.GenericEvent
+	// mapAvailable is true if the cluster serves the MutatingAdmissionPolicy API
+	mapAvailable bool
 }
 
 // Reconcile updates the ValidatingAdmissionPolicy and ValidatingAdmissionPolicyBinding
@@ -128,17 +196,21 @@ func (r *ReconcileAdmissionPolicy) Reconcile(ctx context.Context, req reconcile.
 	logger.Info(fmt.Sprintf("Reconciling admission policy %s", req.Name))
 
 	startup := startupReq == req
-	var policyErr, bindingErr error
+	var policyErr, bindingErr, hcPoliciesErr error
 
 	if req.Name == policyName || startup {
-		policyErr = r.reconcilePolicy(ctx, logger)
+		policyErr = r.reconcilePolicy(ctx, logger, getRequiredPolicy(r.owner))
 	}
 
 	if req.Name == policyBindingName || startup {
-		bindingErr = r.reconcileBinding(ctx, logger)
+		bindingErr = r.reconcileBinding(ctx, logger, getRequiredBinding(r.owner))
+	}
+
+	if req == hyperConvergedReq || isVMGovernanceResource(req.Name) || isVMDefaultsResource(req.Name) || isOperandProtectionResource(req.Name) || startup {
+		hcPoliciesErr = r.reconcileHyperConvergedPolicies(ctx, logger)
 	}
 
-	err = errors.Join(policyErr, bindingErr)
+	err = errors.Join(policyErr, bindingErr, hcPoliciesErr)
 	if err != nil {
 		logger.Error(err, "Reconciliation failed")
 	}
@@ -146,8 +218,93 @@ func (r *ReconcileAdmissionPolicy) Reconcile(ctx context.Context, req reconcile.
 	return reconcile.Result{}, err
 }
 
-func (r *ReconcileAdmissionPolicy) reconcilePolicy(ctx context.Context, logger logr.Logger) error {
-	policy := getRequiredPolicy(r.owner)
+// reconcileHyperConvergedPolicies reconciles the admission policies that are configured in the HyperConverged CR
+func (r *ReconcileAdmissionPolicy) reconcileHyperConvergedPolicies(ctx context.Context, logger logr.Logger) error {
+	hc := &hcov1beta1.HyperConverged{}
+	err := r.Get(ctx, k8stypes.NamespacedName{Name: hcov1beta1.HyperConvergedName, Namespace: hcoutil.GetOperatorNamespaceFromEnv()}, hc)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	// the policies are removed when the HyperConverged CR is missing or is being deleted
+	hcExists := err == nil && hc.DeletionTimestamp == nil
+
+	governancePolicies := &hcov1beta1.VMGovernancePolicies{}
+	var vmDefaults []hcov1beta1.VirtualMachineDefaults
+	protectOperands := false
+	if hcExists {
+		if hc.Spec.VMGovernancePolicies != nil {
+			governancePolicies = hc.Spec.VMGovernancePolicies
+		}
+		vmDefaults = hc.Spec.VirtualMachineDefaults
+		protectOperands = ptr.Deref(hc.Spec.ProtectOperandCRs, false)
+	}
+
+	governanceErr := r.reconcileVMGovernancePolicies(ctx, logger, governancePolicies)
+	protectionErr := r.reconcileOperandProtectionPolicy(ctx, logger, protectOperands)
+	vmDefaultsStatus, vmDefaultsErr := r.reconcileVMDefaultsPolicies(ctx, logger, vmDefaults)
+
+	// the HyperConverged controller is the only writer of the HyperConverged status; let it know that the status of
+	// the VM defaults was changed
+	if setVirtualMachineDefaultsStatus(vmDefaultsStatus) && hcExists && r.hcEvents != nil {
+		r.hcEvents <- event.GenericEvent{}
+	}
+
+	return errors.Join(governanceErr, protectionErr, vmDefaultsErr)
+}
+
+// reconcileOperandProtectionPolicy creates the operand protection policy and its binding if enabled, or removes
+// them if disabled
+func (r *ReconcileAdmissionPolicy) reconcileOperandProtectionPolicy(ctx context.Context, logger logr.Logger, enabled bool) error {
+	if enabled {
+		return errors.Join(
+			r.reconcilePolicy(ctx, logger, getOperandProtectionPolicy(r.owner)),
+			r.reconcileBinding(ctx, logger, getOperandProtectionBinding(r.owner)),
+		)
+	}
+
+	objects := []client.Object{
+		&admissionv1.ValidatingAdmissionPolicyBinding{ObjectMeta: metav1.ObjectMeta{Name: operandProtectionPolicyBindingName}},
+		&admissionv1.ValidatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: operandProtectionPolicyName}},
+	}
+
+	for _, obj := range objects {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		logger.Info("the operand protection is disabled; deleting its resource", "name", obj.GetName())
+		if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ReconcileAdmissionPolicy) reconcileVMGovernancePolicies(ctx context.Context, logger logr.Logger, policies *hcov1beta1.VMGovernancePolicies) error {
+	var errs []error
+	for _, policy := range vmGovernancePolicies {
+		namespaceSelector, params, enabled := policy.getConfig(policies)
+		if !enabled {
+			errs = append(errs, r.deleteVMGovernancePolicy(ctx, logger, policy))
+			continue
+		}
+
+		errs = append(errs,
+			r.reconcileParams(ctx, logger, policy.getParams(r.owner, params)),
+			r.reconcilePolicy(ctx, logger, policy.getPolicy(r.owner)),
+			r.reconcileBinding(ctx, logger, policy.getBinding(r.owner, namespaceSelector)),
+		)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *ReconcileAdmissionPolicy) reconcilePolicy(ctx context.Context, logger logr.Logger, policy *admissionv1.ValidatingAdmissionPolicy) error {
 	key := client.ObjectKeyFromObject(policy)
 	foundPolicy := &admissionv1.ValidatingAdmissionPolicy{}
 
@@ -184,9 +341,7 @@ func (r *ReconcileAdmissionPolicy) reconcilePolicy(ctx context.Context, logger l
 	return nil
 }
 
-func (r *ReconcileAdmissionPolicy) reconcileBinding(ctx context.Context, logger logr.Logger) error {
-	binding := getRequiredBinding(r.owner)
-
+func (r *ReconcileAdmissionPolicy) reconcileBinding(ctx context.Context, logger logr.Logger, binding *admissionv1.ValidatingAdmissionPolicyBinding) error {
 	key := client.ObjectKeyFromObject(binding)
 	foundBinding := &admissionv1.ValidatingAdmissionPolicyBinding{}
 
@@ -222,3 +377,221 @@ func (r *ReconcileAdmissionPolicy) reconcileBinding(ctx context.Context, logger
 
 	return nil
 }
+
+func (r *ReconcileAdmissionPolicy) reconcileParams(ctx context.Context, logger logr.Logger, params *corev1.ConfigMap) error {
+	key := client.ObjectKeyFromObject(params)
+	foundParams := &corev1.ConfigMap{}
+
+	if err := r.Get(ctx, key, foundParams); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("the policy params ConfigMap does not exist; creating it", "name", params.Name)
+			return r.Create(ctx, params.DeepCopy())
+		}
+
+		return err
+	}
+
+	changed := false
+	if !maps.Equal(foundParams.Data, params.Data) {
+		foundParams.Data = maps.Clone(params.Data)
+		changed = true
+	}
+
+	if !reflect.DeepEqual(foundParams.OwnerReferences, params.OwnerReferences) {
+		foundParams.OwnerReferences = slices.Clone(params.OwnerReferences)
+		changed = true
+	}
+
+	if !hcoutil.CompareLabels(params, foundParams) {
+		hcoutil.MergeLabels(&params.ObjectMeta, &foundParams.ObjectMeta)
+		changed = true
+	}
+
+	if changed {
+		logger.Info("the policy params ConfigMap was modified; updating it", "name", params.Name)
+		return r.Update(ctx, foundParams)
+	}
+
+	return nil
+}
+
+func (r *ReconcileAdmissionPolicy) deleteVMGovernancePolicy(ctx context.Context, logger logr.Logger, policy vmGovernancePolicy) error {
+	objects := []client.Object{
+		&admissionv1.ValidatingAdmissionPolicyBinding{ObjectMeta: metav1.ObjectMeta{Name: policy.bindingName()}},
+		&admissionv1.ValidatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: policy.name}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: policy.paramsName(), Namespace: hcoutil.GetOperatorNamespaceFromEnv()}},
+	}
+
+	for _, obj := range objects {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		logger.Info("the VM governance policy is disabled; deleting its resource", "policy", policy.name, "name", obj.GetName())
+		if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileVMDefaultsPolicies reconciles the MutatingAdmissionPolicies of the VM defaults, and returns their status
+func (r *ReconcileAdmissionPolicy) reconcileVMDefaultsPolicies(ctx context.Context, logger logr.Logger, vmDefaults []hcov1beta1.VirtualMachineDefaults) ([]hcov1beta1.VirtualMachineDefaultsStatus, error) {
+	var statuses []hcov1beta1.VirtualMachineDefaultsStatus
+
+	if !r.mapAvailable {
+		for _, defaults := range vmDefaults {
+			statuses = append(statuses, hcov1beta1.VirtualMachineDefaultsStatus{
+				Name:    defaults.Name,
+				Active:  false,
+				Message: mapNotAvailableMessage,
+			})
+		}
+		return statuses, nil
+	}
+
+	var errs []error
+	requiredPolicies := make(map[string]bool, len(vmDefaults))
+	for _, defaults := range vmDefaults {
+		policy := getVMDefaultsPolicy(r.owner, defaults)
+		requiredPolicies[policy.Name] = true
+
+		err := errors.Join(
+			r.reconcileMutatingPolicy(ctx, logger, policy),
+			r.reconcileMutatingBinding(ctx, logger, getVMDefaultsBinding(r.owner, defaults)),
+		)
+
+		status := hcov1beta1.VirtualMachineDefaultsStatus{
+			Name:       defaults.Name,
+			PolicyName: policy.Name,
+			Active:     err == nil,
+		}
+		if err != nil {
+			status.Message = err.Error()
+			errs = append(errs, err)
+		}
+		statuses = append(statuses, status)
+	}
+
+	errs = append(errs, r.deleteStaleVMDefaultsPolicies(ctx, logger, requiredPolicies))
+
+	return statuses, errors.Join(errs...)
+}
+
+func (r *ReconcileAdmissionPolicy) reconcileMutatingPolicy(ctx context.Context, logger logr.Logger, policy *admissionv1beta1.MutatingAdmissionPolicy) error {
+	key := client.ObjectKeyFromObject(policy)
+	foundPolicy := &admissionv1beta1.MutatingAdmissionPolicy{}
+
+	if err := r.Get(ctx, key, foundPolicy); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("MutatingAdmissionPolicy does not exist; creating it", "name", policy.Name)
+			return r.Create(ctx, policy.DeepCopy())
+		}
+
+		return err
+	}
+
+	changed := false
+	if !reflect.DeepEqual(foundPolicy.Spec, policy.Spec) {
+		policy.Spec.DeepCopyInto(&foundPolicy.Spec)
+		changed = true
+	}
+
+	if !reflect.DeepEqual(foundPolicy.OwnerReferences, policy.OwnerReferences) {
+		foundPolicy.OwnerReferences = slices.Clone(policy.OwnerReferences)
+		changed = true
+	}
+
+	if !hcoutil.CompareLabels(policy, foundPolicy) {
+		hcoutil.MergeLabels(&policy.ObjectMeta, &foundPolicy.ObjectMeta)
+		changed = true
+	}
+
+	if changed {
+		logger.Info("MutatingAdmissionPolicy was modified; updating it", "name", policy.Name)
+		return r.Update(ctx, foundPolicy)
+	}
+
+	return nil
+}
+
+func (r *ReconcileAdmissionPolicy) reconcileMutatingBinding(ctx context.Context, logger logr.Logger, binding *admissionv1beta1.MutatingAdmissionPolicyBinding) error {
+	key := client.ObjectKeyFromObject(binding)
+	foundBinding := &admissionv1beta1.MutatingAdmissionPolicyBinding{}
+
+	if err := r.Get(ctx, key, foundBinding); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("MutatingAdmissionPolicyBinding does not exist; creating it", "name", binding.Name)
+			return r.Create(ctx, binding.DeepCopy())
+		}
+
+		return err
+	}
+
+	changed := false
+	if !reflect.DeepEqual(foundBinding.Spec, binding.Spec) {
+		binding.Spec.DeepCopyInto(&foundBinding.Spec)
+		changed = true
+	}
+
+	if !reflect.DeepEqual(foundBinding.OwnerReferences, binding.OwnerReferences) {
+		foundBinding.OwnerReferences = slices.Clone(binding.OwnerReferences)
+		changed = true
+	}
+
+	if !hcoutil.CompareLabels(binding, foundBinding) {
+		hcoutil.MergeLabels(&binding.ObjectMeta, &foundBinding.ObjectMeta)
+		changed = true
+	}
+
+	if changed {
+		logger.Info("MutatingAdmissionPolicyBinding was modified; updating it", "name", binding.Name)
+		return r.Update(ctx, foundBinding)
+	}
+
+	return nil
+}
+
+// deleteStaleVMDefaultsPolicies deletes the MutatingAdmissionPolicies and the bindings of VM defaults that were
+// removed from the HyperConverged CR
+func (r *ReconcileAdmissionPolicy) deleteStaleVMDefaultsPolicies(ctx context.Context, logger logr.Logger, requiredPolicies map[string]bool) error {
+	listOpts := client.MatchingLabels{hcoutil.AppLabel: hcov1beta1.HyperConvergedName}
+
+	bindings := &admissionv1beta1.MutatingAdmissionPolicyBindingList{}
+	if err := r.List(ctx, bindings, listOpts); err != nil {
+		return err
+	}
+
+	for _, binding := range bindings.Items {
+		if !isVMDefaultsResource(binding.Name) || requiredPolicies[binding.Spec.PolicyName] {
+			continue
+		}
+
+		logger.Info("the VM defaults were removed; deleting the MutatingAdmissionPolicyBinding", "name", binding.Name)
+		if err := r.Delete(ctx, &binding); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	policies := &admissionv1beta1.MutatingAdmissionPolicyList{}
+	if err := r.List(ctx, policies, listOpts); err != nil {
+		return err
+	}
+
+	for _, policy := range policies.Items {
+		if !isVMDefaultsResource(policy.Name) || requiredPolicies[policy.Name] {
+			continue
+		}
+
+		logger.Info("the VM defaults were removed; deleting the MutatingAdmissionPolicy", "name", policy.Name)
+		if err := r.Delete(ctx, &policy); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package admissionpolicy
+
+import (
+	"fmt"
+
+	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
+	networkaddonsnames "github.com/kubevirt/cluster-network-addons-operator/pkg/names"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	migrationv1alpha1 "kubevirt.io/kubevirt-migration-operator/api/v1alpha1"
+	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	operandProtectionPolicyName        = "hyperconverged-operand-protection-policy"
+	operandProtectionPolicyBindingName = operandProtectionPolicyName + bindingSuffix
+)
+
+// protectedOperand is an operand CR that is owned by HCO, and that should only be modified by HCO
+type protectedOperand struct {
+	group      string
+	resource   string
+	name       string
+	namespaced bool
+	// the HyperConverged CR fields and the jsonpatch annotation to use, instead of modifying the operand CR. There is
+	// no jsonpatch annotation for some of the operands.
+	hcFields            string
+	jsonPatchAnnotation string
+}
+
+var protectedOperands = []protectedOperand{
+	{
+		group:               kubevirtcorev1.GroupVersion.Group,
+		resource:            "kubevirts",
+		name:                "kubevirt-" + hcoutil.HyperConvergedName,
+		namespaced:          true,
+		hcFields:            "spec.featureGates, spec.liveMigrationConfig, spec.workloadUpdateStrategy, spec.permittedHostDevices, spec.mediatedDevicesConfiguration, spec.virtualMachineOptions, spec.higherWorkloadDensity",
+		jsonPatchAnnotation: common.JSONPatchKVAnnotationName,
+	},
+	{
+		group:               cdiv1beta1.SchemeGroupVersion.Group,
+		resource:            "cdis",
+		name:                "cdi-" + hcoutil.HyperConvergedName,
+		hcFields:            "spec.storageImport, spec.scratchSpaceStorageClass, spec.filesystemOverhead",
+		jsonPatchAnnotation: common.JSONPatchCDIAnnotationName,
+	},
+	{
+		group:               networkaddonsv1.GroupVersion.Group,
+		resource:            "networkaddonsconfigs",
+		name:                networkaddonsnames.OperatorConfig,
+		hcFields:            "spec.featureGates.deployKubeSecondaryDNS, spec.kubeMacPoolConfiguration",
+		jsonPatchAnnotation: common.JSONPatchCNAOAnnotationName,
+	},
+	{
+		group:               sspv1beta3.GroupVersion.Group,
+		resource:            "ssps",
+		name:                "ssp-" + hcoutil.HyperConvergedName,
+		namespaced:          true,
+		hcFields:            "spec.dataImportCronTemplates, spec.commonTemplatesNamespace",
+		jsonPatchAnnotation: common.JSONPatchSSPAnnotationName,
+	},
+	{
+		group:    aaqv1alpha1.SchemeGroupVersion.Group,
+		resource: "aaqs",
+		name:     "aaq-" + hcoutil.HyperConvergedName,
+		hcFields: "spec.enableApplicationAwareQuota, spec.applicationAwareConfig",
+	},
+	{
+		group:      migrationv1alpha1.GroupVersion.Group,
+		resource:   "migcontrollers",
+		name:       "migcontroller-" + hcoutil.HyperConvergedName,
+		namespaced: true,
+		hcFields:   "spec.infra",
+	},
+}
+
+func isOperandProtectionResource(name string) bool {
+	return name == operandProtectionPolicyName || name == operandProtectionPolicyBindingName
+}
+
+func getOperandProtectionPolicy(owner *metav1.OwnerReference) *admissionv1.ValidatingAdmissionPolicy {
+	namespace := hcoutil.GetOperatorNamespaceFromEnv()
+
+	rules := make([]admissionv1.NamedRuleWithOperations, 0, len(protectedOperands))
+	validations := make([]admissionv1.Validation, 0, len(protectedOperands))
+	for _, operand := range protectedOperands {
+		scope := admissionv1.ClusterScope
+		if operand.namespaced {
+			scope = admissionv1.NamespacedScope
+		}
+
+		rules = append(rules, admissionv1.NamedRuleWithOperations{
+			ResourceNames: []string{operand.name},
+			RuleWithOperations: admissionv1.RuleWithOperations{
+				Rule: admissionv1.Rule{
+					APIGroups:   []string{operand.group},
+					APIVersions: []string{"*"},
+					Resources:   []string{operand.resource},
+					Scope:       ptr.To(scope),
+				},
+				Operations: []admissionv1.OperationType{admissionv1.Update},
+			},
+		})
+
+		validations = append(validations, admissionv1.Validation{
+			Expression: fmt.Sprintf(`request.resource.group != '%s' || request.resource.resource != '%s'`, operand.group, operand.resource),
+			Message:    getOperandProtectionMessage(operand),
+			Reason:     ptr.To(metav1.StatusReasonForbidden),
+		})
+	}
+
+	return &admissionv1.ValidatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            operandProtectionPolicyName,
+			Labels:          hcoutil.GetLabels(hcov1beta1.HyperConvergedName, hcoutil.AppComponentDeployment),
+			OwnerReferences: []metav1.OwnerReference{*owner},
+		},
+		Spec: admissionv1.ValidatingAdmissionPolicySpec{
+			// never block HCO itself, if the policy fails
+			FailurePolicy: ptr.To(admissionv1.Ignore),
+			MatchConstraints: &admissionv1.MatchResources{
+				MatchPolicy:       ptr.To(admissionv1.Equivalent),
+				NamespaceSelector: &metav1.LabelSelector{},
+				ObjectSelector:    &metav1.LabelSelector{},
+				ResourceRules:     rules,
+			},
+			MatchConditions: []admissionv1.MatchCondition{
+				{
+					Name:       "not-hco",
+					Expression: fmt.Sprintf(`request.userInfo.username != 'system:serviceaccount:%s:%s'`, namespace, hcoutil.HCOOperatorName),
+				},
+				{
+					Name:       "hco-namespace",
+					Expression: fmt.Sprintf(`request.namespace == '' || request.namespace == '%s'`, namespace),
+				},
+				{
+					// the operators of the operands update the metadata and the status of their CRs
+					Name:       "spec-modified",
+					Expression: `has(object.spec) != has(oldObject.spec) || (has(object.spec) && object.spec != oldObject.spec)`,
+				},
+			},
+			Validations: validations,
+		},
+	}
+}
+
+func getOperandProtectionBinding(owner *metav1.OwnerReference) *admissionv1.ValidatingAdmissionPolicyBinding {
+	return &admissionv1.ValidatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            operandProtectionPolicyBindingName,
+			Labels:          hcoutil.GetLabels(hcov1beta1.HyperConvergedName, hcoutil.AppComponentDeployment),
+			OwnerReferences: []metav1.OwnerReference{*owner},
+		},
+		Spec: admissionv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName:        operandProtectionPolicyName,
+			ValidationActions: []admissionv1.ValidationAction{admissionv1.Deny},
+		},
+	}
+}
+
+func getOperandProtectionMessage(operand protectedOperand) string {
+	msg := fmt.Sprintf(
+		"the %s %s is managed by the HyperConverged CR, and direct modifications are not allowed. "+
+			"Use the HyperConverged CR fields (e.g. %s)",
+		operand.resource, operand.name, operand.hcFields,
+	)
+	if operand.jsonPatchAnnotation != "" {
+		msg += fmt.Sprintf(", or the %s annotation of the HyperConverged CR", operand.jsonPatchAnnotation)
+	}
+
+	return msg + ", instead"
+}
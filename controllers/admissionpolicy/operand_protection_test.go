@@ -0,0 +1,155 @@
+package admissionpolicy
+
+import (
+	"context"
+	"os"
+
+	"github.com/google/cel-go/cel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Operand protection policy", func() {
+	BeforeEach(func() {
+		origNS, hasNSVar := os.LookupEnv(hcoutil.OperatorNamespaceEnv)
+		Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, commontestutils.Namespace)).To(Succeed())
+
+		DeferCleanup(func() {
+			if hasNSVar {
+				Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, origNS)).To(Succeed())
+			} else {
+				Expect(os.Unsetenv(hcoutil.OperatorNamespaceEnv)).To(Succeed())
+			}
+		})
+	})
+
+	It("should have valid CEL expressions", func() {
+		env, err := cel.NewEnv()
+		Expect(err).ToNot(HaveOccurred())
+
+		policy := getOperandProtectionPolicy(ref)
+
+		var expressions []string
+		for _, validation := range policy.Spec.Validations {
+			expressions = append(expressions, validation.Expression)
+		}
+		for _, condition := range policy.Spec.MatchConditions {
+			expressions = append(expressions, condition.Expression)
+		}
+
+		for _, expression := range expressions {
+			_, issues := env.Parse(expression)
+			Expect(issues.Err()).ToNot(HaveOccurred(), "expression: %s", expression)
+		}
+	})
+
+	It("should exclude the HCO service account, and point to the HyperConverged CR", func() {
+		policy := getOperandProtectionPolicy(ref)
+
+		Expect(policy.Spec.MatchConditions).To(ContainElement(admissionv1.MatchCondition{
+			Name:       "not-hco",
+			Expression: "request.userInfo.username != 'system:serviceaccount:" + commontestutils.Namespace + ":hyperconverged-cluster-operator'",
+		}))
+
+		Expect(policy.Spec.MatchConstraints.ResourceRules).To(HaveLen(len(protectedOperands)))
+		Expect(policy.Spec.MatchConstraints.ResourceRules[0].ResourceNames).To(ConsistOf("kubevirt-kubevirt-hyperconverged"))
+		Expect(policy.Spec.MatchConstraints.ResourceRules[0].Operations).To(ConsistOf(admissionv1.Update))
+		Expect(policy.Spec.MatchConstraints.ResourceRules[1].ResourceNames).To(ConsistOf("cdi-kubevirt-hyperconverged"))
+
+		Expect(policy.Spec.Validations).To(HaveLen(len(protectedOperands)))
+		Expect(policy.Spec.Validations[0].Message).To(ContainSubstring(common.JSONPatchKVAnnotationName))
+		Expect(policy.Spec.Validations[1].Message).To(ContainSubstring(common.JSONPatchCDIAnnotationName))
+	})
+
+	It("should protect the AAQ and the MigController CRs, without pointing to a jsonpatch annotation", func() {
+		policy := getOperandProtectionPolicy(ref)
+
+		Expect(policy.Spec.MatchConstraints.ResourceRules).To(ContainElements(
+			HaveField("ResourceNames", ConsistOf("aaq-kubevirt-hyperconverged")),
+			HaveField("ResourceNames", ConsistOf("migcontroller-kubevirt-hyperconverged")),
+		))
+
+		Expect(policy.Spec.Validations).To(ContainElements(
+			HaveField("Message", Equal("the aaqs aaq-kubevirt-hyperconverged is managed by the HyperConverged CR, and direct modifications are not allowed. "+
+				"Use the HyperConverged CR fields (e.g. spec.enableApplicationAwareQuota, spec.applicationAwareConfig), instead")),
+			HaveField("Message", Equal("the migcontrollers migcontroller-kubevirt-hyperconverged is managed by the HyperConverged CR, and direct modifications are not allowed. "+
+				"Use the HyperConverged CR fields (e.g. spec.infra), instead")),
+		))
+	})
+
+	Context("reconcile", func() {
+		var (
+			hc  *hcov1beta1.HyperConverged
+			cli client.Client
+			r   *ReconcileAdmissionPolicy
+		)
+
+		BeforeEach(func() {
+			hc = commontestutils.NewHco()
+			hc.Spec.ProtectOperandCRs = ptr.To(true)
+		})
+
+		reconcileProtection := func(ctx context.Context) {
+			cli = commontestutils.InitClient([]client.Object{hc})
+			r = &ReconcileAdmissionPolicy{
+				Client: cli,
+				owner:  ref,
+			}
+
+			res, err := r.Reconcile(ctx, hyperConvergedReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.IsZero()).To(BeTrue())
+		}
+
+		It("should create the policy and the binding if enabled", func(ctx context.Context) {
+			reconcileProtection(ctx)
+
+			policy := &admissionv1.ValidatingAdmissionPolicy{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: operandProtectionPolicyName}, policy)).To(Succeed())
+			Expect(policy.OwnerReferences).To(ConsistOf(*ref))
+
+			binding := &admissionv1.ValidatingAdmissionPolicyBinding{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: operandProtectionPolicyBindingName}, binding)).To(Succeed())
+			Expect(binding.Spec.PolicyName).To(Equal(operandProtectionPolicyName))
+			Expect(binding.Spec.ValidationActions).To(ConsistOf(admissionv1.Deny))
+		})
+
+		It("should revert a modified policy", func(ctx context.Context) {
+			reconcileProtection(ctx)
+
+			policy := &admissionv1.ValidatingAdmissionPolicy{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: operandProtectionPolicyName}, policy)).To(Succeed())
+			policy.Spec.Validations = nil
+			Expect(cli.Update(ctx, policy)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, reconcileRequest(operandProtectionPolicyName))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: operandProtectionPolicyName}, policy)).To(Succeed())
+			Expect(policy.Spec.Validations).To(HaveLen(len(protectedOperands)))
+		})
+
+		It("should delete the policy and the binding if disabled", func(ctx context.Context) {
+			reconcileProtection(ctx)
+
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(hc), hc)).To(Succeed())
+			hc.Spec.ProtectOperandCRs = ptr.To(false)
+			Expect(cli.Update(ctx, hc)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, hyperConvergedReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: operandProtectionPolicyName}, &admissionv1.ValidatingAdmissionPolicy{})).To(MatchError(k8serrors.IsNotFound, "not found"))
+			Expect(cli.Get(ctx, client.ObjectKey{Name: operandProtectionPolicyBindingName}, &admissionv1.ValidatingAdmissionPolicyBinding{})).To(MatchError(k8serrors.IsNotFound, "not found"))
+		})
+	})
+})
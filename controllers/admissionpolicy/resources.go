@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	admissionv1 "k8s.io/api/admissionregistration/v1"
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -30,11 +32,23 @@ var (
 	bindingOnce = &sync.Once{}
 
 	policyPredicate = predicate.NewTypedPredicateFuncs[*admissionv1.ValidatingAdmissionPolicy](func(policy *admissionv1.ValidatingAdmissionPolicy) bool {
-		return policy.Name == policyName
+		return policy.Name == policyName || isVMGovernanceResource(policy.Name) || isOperandProtectionResource(policy.Name)
 	})
 
 	bindingPredicate = predicate.NewTypedPredicateFuncs[*admissionv1.ValidatingAdmissionPolicyBinding](func(binding *admissionv1.ValidatingAdmissionPolicyBinding) bool {
-		return binding.Name == policyBindingName
+		return binding.Name == policyBindingName || isVMGovernanceResource(binding.Name) || isOperandProtectionResource(binding.Name)
+	})
+
+	mutatingPolicyPredicate = predicate.NewTypedPredicateFuncs[*admissionv1beta1.MutatingAdmissionPolicy](func(policy *admissionv1beta1.MutatingAdmissionPolicy) bool {
+		return isVMDefaultsResource(policy.Name)
+	})
+
+	mutatingBindingPredicate = predicate.NewTypedPredicateFuncs[*admissionv1beta1.MutatingAdmissionPolicyBinding](func(binding *admissionv1beta1.MutatingAdmissionPolicyBinding) bool {
+		return isVMDefaultsResource(binding.Name)
+	})
+
+	paramsPredicate = predicate.NewTypedPredicateFuncs[*corev1.ConfigMap](func(cm *corev1.ConfigMap) bool {
+		return cm.Namespace == hcoutil.GetOperatorNamespaceFromEnv() && isVMGovernanceResource(cm.Name)
 	})
 )
 
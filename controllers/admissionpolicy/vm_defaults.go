@@ -0,0 +1,206 @@
+package admissionpolicy
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	vmDefaultsPolicyPrefix = "hyperconverged-vm-defaults-"
+
+	mapNotAvailableMessage = "the MutatingAdmissionPolicy API (admissionregistration.k8s.io/v1beta1) is not enabled in the cluster"
+
+	defaultPodInterfaceName = "default"
+)
+
+var (
+	// vmDefaultsStatus is the status of the VM defaults policies, that is set in the HyperConverged status by the
+	// HyperConverged controller
+	vmDefaultsStatus     []hcov1beta1.VirtualMachineDefaultsStatus
+	vmDefaultsStatusLock sync.RWMutex
+)
+
+// GetVirtualMachineDefaultsStatus returns the status of the VM defaults policies, as found in the last reconciliation
+// of the admission policy controller
+func GetVirtualMachineDefaultsStatus() []hcov1beta1.VirtualMachineDefaultsStatus {
+	vmDefaultsStatusLock.RLock()
+	defer vmDefaultsStatusLock.RUnlock()
+
+	return slices.Clone(vmDefaultsStatus)
+}
+
+// setVirtualMachineDefaultsStatus stores the status of the VM defaults policies, and returns true if it was changed
+func setVirtualMachineDefaultsStatus(status []hcov1beta1.VirtualMachineDefaultsStatus) bool {
+	vmDefaultsStatusLock.Lock()
+	defer vmDefaultsStatusLock.Unlock()
+
+	if reflect.DeepEqual(vmDefaultsStatus, status) {
+		return false
+	}
+
+	vmDefaultsStatus = status
+	return true
+}
+
+func isVMDefaultsResource(name string) bool {
+	return strings.HasPrefix(name, vmDefaultsPolicyPrefix)
+}
+
+func getVMDefaultsPolicyName(defaults hcov1beta1.VirtualMachineDefaults) string {
+	return vmDefaultsPolicyPrefix + defaults.Name
+}
+
+func getVMDefaultsPolicy(owner *metav1.OwnerReference, defaults hcov1beta1.VirtualMachineDefaults) *admissionv1beta1.MutatingAdmissionPolicy {
+	return &admissionv1beta1.MutatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            getVMDefaultsPolicyName(defaults),
+			Labels:          hcoutil.GetLabels(hcov1beta1.HyperConvergedName, hcoutil.AppComponentDeployment),
+			OwnerReferences: []metav1.OwnerReference{*owner},
+		},
+		Spec: admissionv1beta1.MutatingAdmissionPolicySpec{
+			// the defaults are not critical; don't block the creation of virtual machines if the policy fails
+			FailurePolicy: ptr.To(admissionv1beta1.Ignore),
+			MatchConstraints: &admissionv1beta1.MatchResources{
+				MatchPolicy:       ptr.To(admissionv1beta1.Equivalent),
+				NamespaceSelector: &metav1.LabelSelector{},
+				ObjectSelector:    &metav1.LabelSelector{},
+				ResourceRules: []admissionv1beta1.NamedRuleWithOperations{
+					{
+						RuleWithOperations: admissionv1beta1.RuleWithOperations{
+							Rule: admissionv1.Rule{
+								APIGroups:   []string{kubevirtcorev1.GroupVersion.Group},
+								APIVersions: []string{"*"},
+								Resources:   []string{"virtualmachines"},
+								Scope:       ptr.To(admissionv1.NamespacedScope),
+							},
+							Operations: []admissionv1.OperationType{admissionv1.Create},
+						},
+					},
+				},
+			},
+			Mutations:          getVMDefaultsMutations(defaults),
+			ReinvocationPolicy: admissionv1beta1.NeverReinvocationPolicy,
+		},
+	}
+}
+
+func getVMDefaultsBinding(owner *metav1.OwnerReference, defaults hcov1beta1.VirtualMachineDefaults) *admissionv1beta1.MutatingAdmissionPolicyBinding {
+	namespaceSelector := defaults.NamespaceSelector
+	if namespaceSelector == nil {
+		namespaceSelector = &metav1.LabelSelector{}
+	}
+
+	policyName := getVMDefaultsPolicyName(defaults)
+	return &admissionv1beta1.MutatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            policyName + bindingSuffix,
+			Labels:          hcoutil.GetLabels(hcov1beta1.HyperConvergedName, hcoutil.AppComponentDeployment),
+			OwnerReferences: []metav1.OwnerReference{*owner},
+		},
+		Spec: admissionv1beta1.MutatingAdmissionPolicyBindingSpec{
+			PolicyName: policyName,
+			MatchResources: &admissionv1beta1.MatchResources{
+				NamespaceSelector: namespaceSelector.DeepCopy(),
+			},
+		},
+	}
+}
+
+func getVMDefaultsMutations(defaults hcov1beta1.VirtualMachineDefaults) []admissionv1beta1.Mutation {
+	var expressions []string
+
+	if defaults.Preference != nil {
+		kind := defaults.Preference.Kind
+		if kind == "" {
+			kind = "VirtualMachineClusterPreference"
+		}
+
+		expressions = append(expressions, fmt.Sprintf(`has(object.spec.preference) ? [] : [
+  JSONPatch{op: "add", path: "/spec/preference", value: Object.spec.preference{name: %s, kind: %s}}
+]`, strconv.Quote(defaults.Preference.Name), strconv.Quote(kind)))
+	}
+
+	if defaults.TerminationGracePeriodSeconds != nil {
+		expressions = append(expressions, fmt.Sprintf(`has(object.spec.template.spec.terminationGracePeriodSeconds) ? [] : [
+  JSONPatch{op: "add", path: "/spec/template/spec/terminationGracePeriodSeconds", value: %d}
+]`, *defaults.TerminationGracePeriodSeconds))
+	}
+
+	if defaults.NetworkInterfaceBinding != nil {
+		expressions = append(expressions, getNetworkInterfaceBindingExpression(*defaults.NetworkInterfaceBinding))
+	}
+
+	if len(defaults.Labels) > 0 {
+		expressions = append(expressions,
+			getLabelsExpression(defaults.Labels, "object.metadata", "/metadata", ""),
+			getLabelsExpression(defaults.Labels, "object.spec.template.metadata", "/spec/template/metadata", "Object.spec.template.metadata{}"),
+		)
+	}
+
+	mutations := make([]admissionv1beta1.Mutation, 0, len(expressions))
+	for _, expression := range expressions {
+		mutations = append(mutations, admissionv1beta1.Mutation{
+			PatchType: admissionv1beta1.PatchTypeJSONPatch,
+			JSONPatch: &admissionv1beta1.JSONPatch{
+				Expression: expression,
+			},
+		})
+	}
+
+	return mutations
+}
+
+// getNetworkInterfaceBindingExpression adds an explicit pod network interface with the required binding, to virtual
+// machines that would otherwise get the pod network interface automatically attached.
+func getNetworkInterfaceBindingExpression(binding string) string {
+	const interfaceType = "Object.spec.template.spec.domain.devices.interfaces"
+
+	var bindingField string
+	switch binding {
+	case "bridge", "masquerade":
+		bindingField = fmt.Sprintf("%[1]s: %[2]s.%[1]s{}", binding, interfaceType)
+	default:
+		bindingField = fmt.Sprintf("binding: %s.binding{name: %s}", interfaceType, strconv.Quote(binding))
+	}
+
+	return fmt.Sprintf(`has(object.spec.template.spec.domain.devices.interfaces) || has(object.spec.template.spec.networks) ||
+(has(object.spec.template.spec.domain.devices.autoattachPodInterface) && !object.spec.template.spec.domain.devices.autoattachPodInterface) ? [] : [
+  JSONPatch{op: "add", path: "/spec/template/spec/domain/devices/interfaces", value: [%[1]s{name: %[2]q, %[3]s}]},
+  JSONPatch{op: "add", path: "/spec/template/spec/networks", value: [Object.spec.template.spec.networks{name: %[2]q, pod: Object.spec.template.spec.networks.pod{}}]}
+]`, interfaceType, defaultPodInterfaceName, bindingField)
+}
+
+// getLabelsExpression adds the missing labels to the metadata in metadataField. If metadataValue is not empty, the
+// metadata itself is optional, and is added if it is missing.
+func getLabelsExpression(labels map[string]string, metadataField, metadataPath, metadataValue string) string {
+	var patches []string
+
+	hasLabels := fmt.Sprintf("has(%s.labels)", metadataField)
+	if metadataValue != "" {
+		patches = append(patches, fmt.Sprintf(`(has(%s) ? [] : [JSONPatch{op: "add", path: %q, value: %s}])`, metadataField, metadataPath, metadataValue))
+		hasLabels = fmt.Sprintf("has(%s) && %s", metadataField, hasLabels)
+	}
+
+	patches = append(patches, fmt.Sprintf(`(%s ? [] : [JSONPatch{op: "add", path: %q, value: {}}])`, hasLabels, metadataPath+"/labels"))
+
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		patches = append(patches, fmt.Sprintf(`(%[1]s && %[2]s in %[3]s.labels ? [] : [JSONPatch{op: "add", path: %[4]q + jsonpatch.escapeKey(%[2]s), value: %[5]s}])`,
+			hasLabels, strconv.Quote(key), metadataField, metadataPath+"/labels/", strconv.Quote(labels[key])))
+	}
+
+	return strings.Join(patches, " +\n")
+}
@@ -0,0 +1,184 @@
+package admissionpolicy
+
+import (
+	"context"
+	"os"
+
+	"github.com/google/cel-go/cel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("VM defaults", func() {
+	BeforeEach(func() {
+		origNS, hasNSVar := os.LookupEnv(hcoutil.OperatorNamespaceEnv)
+		Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, commontestutils.Namespace)).To(Succeed())
+
+		DeferCleanup(func() {
+			if hasNSVar {
+				Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, origNS)).To(Succeed())
+			} else {
+				Expect(os.Unsetenv(hcoutil.OperatorNamespaceEnv)).To(Succeed())
+			}
+		})
+	})
+
+	allDefaults := hcov1beta1.VirtualMachineDefaults{
+		Name: "all",
+		Preference: &hcov1beta1.VirtualMachineDefaultPreference{
+			Name: "rhel.9",
+		},
+		TerminationGracePeriodSeconds: ptr.To[int64](30),
+		NetworkInterfaceBinding:       ptr.To("passt"),
+		Labels: map[string]string{
+			"example.com/team": "virt",
+			"cost-center":      "1234",
+		},
+	}
+
+	DescribeTable("should have valid CEL expressions", func(defaults hcov1beta1.VirtualMachineDefaults, expectedMutations int) {
+		env, err := cel.NewEnv()
+		Expect(err).ToNot(HaveOccurred())
+
+		mutations := getVMDefaultsMutations(defaults)
+		Expect(mutations).To(HaveLen(expectedMutations))
+
+		for _, mutation := range mutations {
+			Expect(mutation.PatchType).To(Equal(admissionv1beta1.PatchTypeJSONPatch))
+			_, issues := env.Parse(mutation.JSONPatch.Expression)
+			Expect(issues.Err()).ToNot(HaveOccurred(), "expression: %s", mutation.JSONPatch.Expression)
+		}
+	},
+		Entry("all the defaults", allDefaults, 5),
+		Entry("masquerade binding", hcov1beta1.VirtualMachineDefaults{Name: "masquerade", NetworkInterfaceBinding: ptr.To("masquerade")}, 1),
+		Entry("only labels", hcov1beta1.VirtualMachineDefaults{Name: "labels", Labels: map[string]string{"a": "b"}}, 2),
+	)
+
+	Context("reconcile", func() {
+		var (
+			hc       *hcov1beta1.HyperConverged
+			cli      client.Client
+			r        *ReconcileAdmissionPolicy
+			hcEvents chan event.GenericEvent
+		)
+
+		BeforeEach(func() {
+			hc = commontestutils.NewHco()
+			hc.Spec.VirtualMachineDefaults = []hcov1beta1.VirtualMachineDefaults{
+				allDefaults,
+				{
+					Name: "dev",
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"tier": "dev"},
+					},
+					TerminationGracePeriodSeconds: ptr.To[int64](0),
+				},
+			}
+
+			hcEvents = make(chan event.GenericEvent, 10)
+			DeferCleanup(func() {
+				setVirtualMachineDefaultsStatus(nil)
+				close(hcEvents)
+			})
+		})
+
+		reconcileDefaults := func(ctx context.Context, mapAvailable bool) {
+			cli = commontestutils.InitClient([]client.Object{hc})
+			r = &ReconcileAdmissionPolicy{
+				Client:       cli,
+				owner:        ref,
+				hcEvents:     hcEvents,
+				mapAvailable: mapAvailable,
+			}
+
+			res, err := r.Reconcile(ctx, hyperConvergedReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.IsZero()).To(BeTrue())
+		}
+
+		It("should create the policies and the bindings, and report their status to the HyperConverged controller", func(ctx context.Context) {
+			reconcileDefaults(ctx, true)
+
+			policy := &admissionv1beta1.MutatingAdmissionPolicy{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmDefaultsPolicyPrefix + "all"}, policy)).To(Succeed())
+			Expect(policy.Spec.Mutations).To(HaveLen(5))
+			Expect(policy.Spec.FailurePolicy).To(HaveValue(Equal(admissionv1beta1.Ignore)))
+			Expect(policy.OwnerReferences).To(ConsistOf(*ref))
+
+			binding := &admissionv1beta1.MutatingAdmissionPolicyBinding{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmDefaultsPolicyPrefix + "dev" + bindingSuffix}, binding)).To(Succeed())
+			Expect(binding.Spec.PolicyName).To(Equal(vmDefaultsPolicyPrefix + "dev"))
+			Expect(binding.Spec.MatchResources.NamespaceSelector.MatchLabels).To(HaveKeyWithValue("tier", "dev"))
+
+			Expect(GetVirtualMachineDefaultsStatus()).To(Equal([]hcov1beta1.VirtualMachineDefaultsStatus{
+				{Name: "all", PolicyName: vmDefaultsPolicyPrefix + "all", Active: true},
+				{Name: "dev", PolicyName: vmDefaultsPolicyPrefix + "dev", Active: true},
+			}))
+			Expect(hcEvents).To(HaveLen(1))
+
+			By("should not write the HyperConverged status")
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(hc), hc)).To(Succeed())
+			Expect(hc.Status.VirtualMachineDefaults).To(BeEmpty())
+
+			By("should not trigger the HyperConverged controller if the status was not changed")
+			_, err := r.Reconcile(ctx, hyperConvergedReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hcEvents).To(HaveLen(1))
+		})
+
+		It("should revert a modified policy", func(ctx context.Context) {
+			reconcileDefaults(ctx, true)
+
+			policy := &admissionv1beta1.MutatingAdmissionPolicy{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmDefaultsPolicyPrefix + "dev"}, policy)).To(Succeed())
+			policy.Spec.FailurePolicy = ptr.To(admissionv1beta1.Fail)
+			Expect(cli.Update(ctx, policy)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, reconcileRequest(policy.Name))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmDefaultsPolicyPrefix + "dev"}, policy)).To(Succeed())
+			Expect(policy.Spec.FailurePolicy).To(HaveValue(Equal(admissionv1beta1.Ignore)))
+		})
+
+		It("should delete the policies of removed defaults", func(ctx context.Context) {
+			reconcileDefaults(ctx, true)
+
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(hc), hc)).To(Succeed())
+			hc.Spec.VirtualMachineDefaults = hc.Spec.VirtualMachineDefaults[:1]
+			Expect(cli.Update(ctx, hc)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, hyperConvergedReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmDefaultsPolicyPrefix + "dev"}, &admissionv1beta1.MutatingAdmissionPolicy{})).To(MatchError(k8serrors.IsNotFound, "not found"))
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmDefaultsPolicyPrefix + "dev" + bindingSuffix}, &admissionv1beta1.MutatingAdmissionPolicyBinding{})).To(MatchError(k8serrors.IsNotFound, "not found"))
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmDefaultsPolicyPrefix + "all"}, &admissionv1beta1.MutatingAdmissionPolicy{})).To(Succeed())
+
+			Expect(GetVirtualMachineDefaultsStatus()).To(HaveLen(1))
+			Expect(GetVirtualMachineDefaultsStatus()[0].Name).To(Equal("all"))
+			Expect(hcEvents).To(HaveLen(2))
+		})
+
+		It("should report the defaults as inactive if the MutatingAdmissionPolicy API is not available", func(ctx context.Context) {
+			reconcileDefaults(ctx, false)
+
+			Expect(GetVirtualMachineDefaultsStatus()).To(HaveLen(2))
+			for _, status := range GetVirtualMachineDefaultsStatus() {
+				Expect(status.Active).To(BeFalse())
+				Expect(status.PolicyName).To(BeEmpty())
+				Expect(status.Message).To(Equal(mapNotAvailableMessage))
+			}
+		})
+	})
+})
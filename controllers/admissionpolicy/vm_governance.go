@@ -0,0 +1,304 @@
+package admissionpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	vmResourceLimitsPolicyName   = "hyperconverged-vm-resource-limits-policy"
+	vmHostDevicesPolicyName      = "hyperconverged-vm-host-devices-policy"
+	vmEvictionStrategyPolicyName = "hyperconverged-vm-eviction-strategy-policy"
+	vmNetworkBindingsPolicyName  = "hyperconverged-vm-network-bindings-policy"
+
+	bindingSuffix = "-binding"
+	paramsSuffix  = "-params"
+
+	// the params ConfigMap keys. Lists are stored as comma separated values
+	paramMaxCPUs              = "maxCPUs"
+	paramMaxMemory            = "maxMemory"
+	paramAllowedDeviceNames   = "allowedDeviceNames"
+	paramAllowedHostDiskPaths = "allowedHostDiskPaths"
+	paramAllowedStrategies    = "allowedStrategies"
+	paramAllowedBindings      = "allowedBindings"
+
+	// the spec of the VMI template; the policies only validate virtual machines
+	vmSpecVariable = "object.spec.template.spec"
+)
+
+// vmGovernancePolicy is a ValidatingAdmissionPolicy from the governance policy library. It is parameterized by a
+// ConfigMap, and it is bound to the namespaces that are selected in the HyperConverged CR.
+type vmGovernancePolicy struct {
+	name        string
+	variables   []admissionv1.Variable
+	validations []admissionv1.Validation
+	// getConfig returns the namespace selector and the params of the policy, or false if the policy is not enabled
+	getConfig func(policies *hcov1beta1.VMGovernancePolicies) (*metav1.LabelSelector, map[string]string, bool)
+}
+
+var vmGovernancePolicies = []vmGovernancePolicy{
+	{
+		name: vmResourceLimitsPolicyName,
+		variables: []admissionv1.Variable{
+			{Name: "spec", Expression: vmSpecVariable},
+			{Name: "hasCPU", Expression: `has(variables.spec.domain.cpu)`},
+			{
+				Name: "vcpus",
+				Expression: `(variables.hasCPU && has(variables.spec.domain.cpu.sockets) ? int(variables.spec.domain.cpu.sockets) : 1) *
+(variables.hasCPU && has(variables.spec.domain.cpu.cores) ? int(variables.spec.domain.cpu.cores) : 1) *
+(variables.hasCPU && has(variables.spec.domain.cpu.threads) ? int(variables.spec.domain.cpu.threads) : 1)`,
+			},
+			{
+				Name: "memory",
+				Expression: `has(variables.spec.domain.memory) && has(variables.spec.domain.memory.guest) ? quantity(string(variables.spec.domain.memory.guest)) :
+has(variables.spec.domain.resources) && has(variables.spec.domain.resources.requests) && 'memory' in variables.spec.domain.resources.requests ? quantity(string(variables.spec.domain.resources.requests.memory)) :
+quantity('0')`,
+			},
+		},
+		validations: []admissionv1.Validation{
+			{
+				Expression:        `!('` + paramMaxCPUs + `' in params.data) || variables.vcpus <= int(params.data.` + paramMaxCPUs + `)`,
+				MessageExpression: `'the virtual machine requests ' + string(variables.vcpus) + ' vCPUs; the maximum allowed number of vCPUs is ' + params.data.` + paramMaxCPUs,
+				Reason:            ptr.To(metav1.StatusReasonForbidden),
+			},
+			{
+				Expression:        `!('` + paramMaxMemory + `' in params.data) || variables.memory.compareTo(quantity(params.data.` + paramMaxMemory + `)) <= 0`,
+				MessageExpression: `'the virtual machine requests more than the maximum allowed memory of ' + params.data.` + paramMaxMemory,
+				Reason:            ptr.To(metav1.StatusReasonForbidden),
+			},
+		},
+		getConfig: func(policies *hcov1beta1.VMGovernancePolicies) (*metav1.LabelSelector, map[string]string, bool) {
+			policy := policies.ResourceLimits
+			if policy == nil {
+				return nil, nil, false
+			}
+
+			params := map[string]string{}
+			if policy.MaxCPUs != nil {
+				params[paramMaxCPUs] = strconv.FormatUint(uint64(*policy.MaxCPUs), 10)
+			}
+			if policy.MaxMemory != nil {
+				params[paramMaxMemory] = policy.MaxMemory.String()
+			}
+
+			return policy.NamespaceSelector, params, true
+		},
+	},
+	{
+		name: vmHostDevicesPolicyName,
+		variables: []admissionv1.Variable{
+			{Name: "spec", Expression: vmSpecVariable},
+			{Name: "allowedDeviceNames", Expression: listParamExpression(paramAllowedDeviceNames)},
+			{Name: "allowedHostDiskPaths", Expression: listParamExpression(paramAllowedHostDiskPaths)},
+		},
+		validations: []admissionv1.Validation{
+			{
+				Expression: `!has(variables.spec.domain.devices.hostDevices) || variables.spec.domain.devices.hostDevices.all(d, d.deviceName in variables.allowedDeviceNames)`,
+				Message:    "the virtual machine uses a host device that is not allowed",
+				Reason:     ptr.To(metav1.StatusReasonForbidden),
+			},
+			{
+				Expression: `!has(variables.spec.domain.devices.gpus) || variables.spec.domain.devices.gpus.all(d, d.deviceName in variables.allowedDeviceNames)`,
+				Message:    "the virtual machine uses a GPU that is not allowed",
+				Reason:     ptr.To(metav1.StatusReasonForbidden),
+			},
+			{
+				// the path must be one of the allowed paths, or under one of them, and it must not escape it with ".."
+				Expression: `!has(variables.spec.volumes) || variables.spec.volumes.all(v, !has(v.hostDisk) ||
+(!v.hostDisk.path.matches('(^|/)[.][.](/|$)') && variables.allowedHostDiskPaths.exists(p, v.hostDisk.path == p || v.hostDisk.path.startsWith(p.endsWith('/') ? p : p + '/'))))`,
+				Message: "the virtual machine uses a hostDisk volume with a path that is not allowed",
+				Reason:  ptr.To(metav1.StatusReasonForbidden),
+			},
+		},
+		getConfig: func(policies *hcov1beta1.VMGovernancePolicies) (*metav1.LabelSelector, map[string]string, bool) {
+			policy := policies.HostDevices
+			if policy == nil {
+				return nil, nil, false
+			}
+
+			return policy.NamespaceSelector, map[string]string{
+				paramAllowedDeviceNames:   strings.Join(policy.AllowedDeviceNames, ","),
+				paramAllowedHostDiskPaths: strings.Join(policy.AllowedHostDiskPaths, ","),
+			}, true
+		},
+	},
+	{
+		name: vmEvictionStrategyPolicyName,
+		variables: []admissionv1.Variable{
+			{Name: "spec", Expression: vmSpecVariable},
+			{Name: "allowedStrategies", Expression: listParamExpression(paramAllowedStrategies)},
+		},
+		validations: []admissionv1.Validation{
+			{
+				Expression: `has(variables.spec.evictionStrategy)`,
+				Message:    "the virtual machine must explicitly set spec.template.spec.evictionStrategy",
+				Reason:     ptr.To(metav1.StatusReasonForbidden),
+			},
+			{
+				Expression:        `!has(variables.spec.evictionStrategy) || variables.allowedStrategies.size() == 0 || variables.spec.evictionStrategy in variables.allowedStrategies`,
+				MessageExpression: `'the eviction strategy of the virtual machine must be one of: ' + variables.allowedStrategies.join(', ')`,
+				Reason:            ptr.To(metav1.StatusReasonForbidden),
+			},
+		},
+		getConfig: func(policies *hcov1beta1.VMGovernancePolicies) (*metav1.LabelSelector, map[string]string, bool) {
+			policy := policies.EvictionStrategy
+			if policy == nil {
+				return nil, nil, false
+			}
+
+			strategies := make([]string, 0, len(policy.AllowedStrategies))
+			for _, strategy := range policy.AllowedStrategies {
+				strategies = append(strategies, string(strategy))
+			}
+
+			return policy.NamespaceSelector, map[string]string{
+				paramAllowedStrategies: strings.Join(strategies, ","),
+			}, true
+		},
+	},
+	{
+		name: vmNetworkBindingsPolicyName,
+		variables: []admissionv1.Variable{
+			{Name: "spec", Expression: vmSpecVariable},
+			{Name: "allowedBindings", Expression: listParamExpression(paramAllowedBindings)},
+		},
+		validations: []admissionv1.Validation{
+			{
+				Expression:        `!has(variables.spec.domain.devices.interfaces) || variables.spec.domain.devices.interfaces.all(i, ` + interfaceBindingExpression + ` in [''] + variables.allowedBindings)`,
+				MessageExpression: `'the virtual machine uses a network binding that is not allowed; the allowed bindings are: ' + variables.allowedBindings.join(', ')`,
+				Reason:            ptr.To(metav1.StatusReasonForbidden),
+			},
+		},
+		getConfig: func(policies *hcov1beta1.VMGovernancePolicies) (*metav1.LabelSelector, map[string]string, bool) {
+			policy := policies.NetworkBindings
+			if policy == nil {
+				return nil, nil, false
+			}
+
+			return policy.NamespaceSelector, map[string]string{
+				paramAllowedBindings: strings.Join(policy.AllowedBindings, ","),
+			}, true
+		},
+	},
+}
+
+// interfaceBindingExpression is the binding of the interface "i": the binding plugin name, or the binding method.
+// Interfaces that do not explicitly set their binding, are evaluated to an empty string.
+const interfaceBindingExpression = `(has(i.binding) ? i.binding.name :
+has(i.bridge) ? 'bridge' :
+has(i.masquerade) ? 'masquerade' :
+has(i.sriov) ? 'sriov' :
+has(i.macvtap) ? 'macvtap' :
+has(i.passt) ? 'passt' : '')`
+
+// listParamExpression returns a CEL expression that reads a comma separated list from the params ConfigMap
+func listParamExpression(key string) string {
+	return fmt.Sprintf(`has(params.data) && '%[1]s' in params.data && params.data.%[1]s != '' ? params.data.%[1]s.split(',') : []`, key)
+}
+
+func isVMGovernanceResource(name string) bool {
+	return slices.ContainsFunc(vmGovernancePolicies, func(policy vmGovernancePolicy) bool {
+		return name == policy.name || name == policy.bindingName() || name == policy.paramsName()
+	})
+}
+
+func (p vmGovernancePolicy) bindingName() string {
+	return p.name + bindingSuffix
+}
+
+func (p vmGovernancePolicy) paramsName() string {
+	return p.name + paramsSuffix
+}
+
+func (p vmGovernancePolicy) getPolicy(owner *metav1.OwnerReference) *admissionv1.ValidatingAdmissionPolicy {
+	return &admissionv1.ValidatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            p.name,
+			Labels:          hcoutil.GetLabels(hcov1beta1.HyperConvergedName, hcoutil.AppComponentDeployment),
+			OwnerReferences: []metav1.OwnerReference{*owner},
+		},
+		Spec: admissionv1.ValidatingAdmissionPolicySpec{
+			FailurePolicy: ptr.To(admissionv1.Fail),
+			ParamKind: &admissionv1.ParamKind{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "ConfigMap",
+			},
+			MatchConstraints: &admissionv1.MatchResources{
+				MatchPolicy:       ptr.To(admissionv1.Equivalent),
+				NamespaceSelector: &metav1.LabelSelector{},
+				ObjectSelector:    &metav1.LabelSelector{},
+				ResourceRules: []admissionv1.NamedRuleWithOperations{
+					{
+						RuleWithOperations: admissionv1.RuleWithOperations{
+							Rule: admissionv1.Rule{
+								APIGroups:   []string{kubevirtcorev1.GroupVersion.Group},
+								APIVersions: []string{"*"},
+								Resources:   []string{"virtualmachines"},
+								Scope:       ptr.To(admissionv1.NamespacedScope),
+							},
+							Operations: []admissionv1.OperationType{admissionv1.Create, admissionv1.Update},
+						},
+					},
+				},
+			},
+			MatchConditions: []admissionv1.MatchCondition{
+				{
+					// don't block the updates of existing virtual machines, that don't modify the VMI template
+					Name:       "template-modified",
+					Expression: `request.operation != 'UPDATE' || object.spec.template != oldObject.spec.template`,
+				},
+			},
+			Variables:   slices.Clone(p.variables),
+			Validations: slices.Clone(p.validations),
+		},
+	}
+}
+
+func (p vmGovernancePolicy) getBinding(owner *metav1.OwnerReference, namespaceSelector *metav1.LabelSelector) *admissionv1.ValidatingAdmissionPolicyBinding {
+	if namespaceSelector == nil {
+		namespaceSelector = &metav1.LabelSelector{}
+	}
+
+	return &admissionv1.ValidatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            p.bindingName(),
+			Labels:          hcoutil.GetLabels(hcov1beta1.HyperConvergedName, hcoutil.AppComponentDeployment),
+			OwnerReferences: []metav1.OwnerReference{*owner},
+		},
+		Spec: admissionv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName: p.name,
+			ParamRef: &admissionv1.ParamRef{
+				Name:                    p.paramsName(),
+				Namespace:               hcoutil.GetOperatorNamespaceFromEnv(),
+				ParameterNotFoundAction: ptr.To(admissionv1.DenyAction),
+			},
+			MatchResources: &admissionv1.MatchResources{
+				NamespaceSelector: namespaceSelector.DeepCopy(),
+			},
+			ValidationActions: []admissionv1.ValidationAction{admissionv1.Deny},
+		},
+	}
+}
+
+func (p vmGovernancePolicy) getParams(owner *metav1.OwnerReference, params map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            p.paramsName(),
+			Namespace:       hcoutil.GetOperatorNamespaceFromEnv(),
+			Labels:          hcoutil.GetLabels(hcov1beta1.HyperConvergedName, hcoutil.AppComponentDeployment),
+			OwnerReferences: []metav1.OwnerReference{*owner},
+		},
+		Data: params,
+	}
+}
@@ -0,0 +1,272 @@
+package admissionpolicy
+
+import (
+	"context"
+	"os"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("VM governance policies", func() {
+	BeforeEach(func() {
+		origNS, hasNSVar := os.LookupEnv(hcoutil.OperatorNamespaceEnv)
+		Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, commontestutils.Namespace)).To(Succeed())
+
+		DeferCleanup(func() {
+			if hasNSVar {
+				Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, origNS)).To(Succeed())
+			} else {
+				Expect(os.Unsetenv(hcoutil.OperatorNamespaceEnv)).To(Succeed())
+			}
+		})
+	})
+
+	It("should have valid CEL expressions", func() {
+		env, err := cel.NewEnv()
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, policy := range vmGovernancePolicies {
+			vap := policy.getPolicy(ref)
+
+			var expressions []string
+			for _, variable := range vap.Spec.Variables {
+				expressions = append(expressions, variable.Expression)
+			}
+			for _, validation := range vap.Spec.Validations {
+				expressions = append(expressions, validation.Expression)
+				if validation.MessageExpression != "" {
+					expressions = append(expressions, validation.MessageExpression)
+				}
+			}
+			for _, condition := range vap.Spec.MatchConditions {
+				expressions = append(expressions, condition.Expression)
+			}
+
+			for _, expression := range expressions {
+				_, issues := env.Parse(expression)
+				Expect(issues.Err()).ToNot(HaveOccurred(), "policy %s; expression: %s", policy.name, expression)
+			}
+		}
+	})
+
+	DescribeTable("should only allow the hostDisk paths under the allowed paths", func(path string, allowed bool) {
+		env, err := cel.NewEnv(cel.Variable("variables", cel.MapType(cel.StringType, cel.DynType)))
+		Expect(err).ToNot(HaveOccurred())
+
+		idx := slices.IndexFunc(vmGovernancePolicies, func(policy vmGovernancePolicy) bool {
+			return policy.name == vmHostDevicesPolicyName
+		})
+		Expect(idx).ToNot(Equal(-1))
+		validations := vmGovernancePolicies[idx].validations
+		ast, issues := env.Compile(validations[len(validations)-1].Expression)
+		Expect(issues.Err()).ToNot(HaveOccurred())
+		prg, err := env.Program(ast)
+		Expect(err).ToNot(HaveOccurred())
+
+		out, _, err := prg.Eval(map[string]any{
+			"variables": map[string]any{
+				"spec": map[string]any{
+					"volumes": []any{
+						map[string]any{"hostDisk": map[string]any{"path": path}},
+					},
+				},
+				"allowedHostDiskPaths": []string{"/data", "/var/lib/vm-disks/"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.Value()).To(Equal(allowed))
+	},
+		Entry("the allowed path", "/data", true),
+		Entry("a path under the allowed path", "/data/disk.img", true),
+		Entry("a path under an allowed path with a trailing slash", "/var/lib/vm-disks/disk.img", true),
+		Entry("a path with the allowed path as a prefix", "/data-other/disk.img", false),
+		Entry("a path that escapes the allowed path", "/data/../etc/shadow", false),
+		Entry("a path that ends with ..", "/data/disks/..", false),
+		Entry("a path that is not allowed", "/etc/shadow", false),
+	)
+
+	Context("reconcile", func() {
+		var (
+			hc  *hcov1beta1.HyperConverged
+			cli client.Client
+			r   *ReconcileAdmissionPolicy
+		)
+
+		BeforeEach(func() {
+			hc = commontestutils.NewHco()
+			hc.Spec.VMGovernancePolicies = &hcov1beta1.VMGovernancePolicies{
+				ResourceLimits: &hcov1beta1.VMResourceLimitsPolicy{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"tier": "dev"},
+					},
+					MaxCPUs:   ptr.To[uint32](8),
+					MaxMemory: ptr.To(resource.MustParse("16Gi")),
+				},
+				HostDevices: &hcov1beta1.VMHostDevicesPolicy{
+					AllowedDeviceNames:   []string{"nvidia.com/A10", "nvidia.com/T4"},
+					AllowedHostDiskPaths: []string{"/var/lib/vm-disks/"},
+				},
+			}
+		})
+
+		reconcileGovernance := func(ctx context.Context) {
+			cli = commontestutils.InitClient([]client.Object{hc})
+			r = &ReconcileAdmissionPolicy{
+				Client: cli,
+				owner:  ref,
+			}
+
+			res, err := r.Reconcile(ctx, hyperConvergedReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.IsZero()).To(BeTrue())
+		}
+
+		getParams := func(ctx context.Context, policyName string) (*corev1.ConfigMap, error) {
+			cm := &corev1.ConfigMap{}
+			err := cli.Get(ctx, client.ObjectKey{Name: policyName + paramsSuffix, Namespace: commontestutils.Namespace}, cm)
+			return cm, err
+		}
+
+		It("should create the policies, the bindings and the params of the enabled policies", func(ctx context.Context) {
+			reconcileGovernance(ctx)
+
+			policy := &admissionv1.ValidatingAdmissionPolicy{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmResourceLimitsPolicyName}, policy)).To(Succeed())
+			Expect(policy.Spec.ParamKind).To(Equal(&admissionv1.ParamKind{APIVersion: "v1", Kind: "ConfigMap"}))
+			Expect(policy.Spec.MatchConstraints.ResourceRules[0].APIGroups).To(ConsistOf(kubevirtcorev1.GroupVersion.Group))
+			Expect(policy.Spec.MatchConstraints.ResourceRules[0].Resources).To(ConsistOf("virtualmachines"))
+			Expect(policy.OwnerReferences).To(ConsistOf(*ref))
+
+			binding := &admissionv1.ValidatingAdmissionPolicyBinding{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmResourceLimitsPolicyName + bindingSuffix}, binding)).To(Succeed())
+			Expect(binding.Spec.PolicyName).To(Equal(vmResourceLimitsPolicyName))
+			Expect(binding.Spec.ParamRef.Name).To(Equal(vmResourceLimitsPolicyName + paramsSuffix))
+			Expect(binding.Spec.ParamRef.Namespace).To(Equal(commontestutils.Namespace))
+			Expect(binding.Spec.MatchResources.NamespaceSelector.MatchLabels).To(HaveKeyWithValue("tier", "dev"))
+			Expect(binding.Spec.ValidationActions).To(ConsistOf(admissionv1.Deny))
+
+			params, err := getParams(ctx, vmResourceLimitsPolicyName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params.Data).To(Equal(map[string]string{
+				paramMaxCPUs:   "8",
+				paramMaxMemory: "16Gi",
+			}))
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmHostDevicesPolicyName + bindingSuffix}, binding)).To(Succeed())
+			Expect(binding.Spec.MatchResources.NamespaceSelector).To(Equal(&metav1.LabelSelector{}))
+
+			params, err = getParams(ctx, vmHostDevicesPolicyName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params.Data).To(Equal(map[string]string{
+				paramAllowedDeviceNames:   "nvidia.com/A10,nvidia.com/T4",
+				paramAllowedHostDiskPaths: "/var/lib/vm-disks/",
+			}))
+
+			for _, name := range []string{vmEvictionStrategyPolicyName, vmNetworkBindingsPolicyName} {
+				Expect(cli.Get(ctx, client.ObjectKey{Name: name}, &admissionv1.ValidatingAdmissionPolicy{})).To(MatchError(k8serrors.IsNotFound, "not found"))
+				Expect(cli.Get(ctx, client.ObjectKey{Name: name + bindingSuffix}, &admissionv1.ValidatingAdmissionPolicyBinding{})).To(MatchError(k8serrors.IsNotFound, "not found"))
+				_, err = getParams(ctx, name)
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "not found"))
+			}
+		})
+
+		It("should update the params when the HyperConverged CR is modified", func(ctx context.Context) {
+			reconcileGovernance(ctx)
+
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(hc), hc)).To(Succeed())
+			hc.Spec.VMGovernancePolicies.ResourceLimits.MaxMemory = nil
+			hc.Spec.VMGovernancePolicies.EvictionStrategy = &hcov1beta1.VMEvictionStrategyPolicy{
+				AllowedStrategies: []kubevirtcorev1.EvictionStrategy{kubevirtcorev1.EvictionStrategyLiveMigrate},
+			}
+			Expect(cli.Update(ctx, hc)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, hyperConvergedReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			params, err := getParams(ctx, vmResourceLimitsPolicyName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params.Data).To(Equal(map[string]string{paramMaxCPUs: "8"}))
+
+			params, err = getParams(ctx, vmEvictionStrategyPolicyName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params.Data).To(Equal(map[string]string{paramAllowedStrategies: "LiveMigrate"}))
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmEvictionStrategyPolicyName}, &admissionv1.ValidatingAdmissionPolicy{})).To(Succeed())
+		})
+
+		It("should revert a modified params ConfigMap", func(ctx context.Context) {
+			reconcileGovernance(ctx)
+
+			params, err := getParams(ctx, vmResourceLimitsPolicyName)
+			Expect(err).ToNot(HaveOccurred())
+			params.Data[paramMaxCPUs] = "128"
+			Expect(cli.Update(ctx, params)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, reconcileRequest(params.Name))
+			Expect(err).ToNot(HaveOccurred())
+
+			params, err = getParams(ctx, vmResourceLimitsPolicyName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params.Data).To(HaveKeyWithValue(paramMaxCPUs, "8"))
+		})
+
+		It("should delete the resources of a disabled policy", func(ctx context.Context) {
+			reconcileGovernance(ctx)
+
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(hc), hc)).To(Succeed())
+			hc.Spec.VMGovernancePolicies.HostDevices = nil
+			Expect(cli.Update(ctx, hc)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, hyperConvergedReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmHostDevicesPolicyName}, &admissionv1.ValidatingAdmissionPolicy{})).To(MatchError(k8serrors.IsNotFound, "not found"))
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmHostDevicesPolicyName + bindingSuffix}, &admissionv1.ValidatingAdmissionPolicyBinding{})).To(MatchError(k8serrors.IsNotFound, "not found"))
+			_, err = getParams(ctx, vmHostDevicesPolicyName)
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "not found"))
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: vmResourceLimitsPolicyName}, &admissionv1.ValidatingAdmissionPolicy{})).To(Succeed())
+		})
+
+		It("should not create any VM governance policy if the HyperConverged CR is missing", func(ctx context.Context) {
+			cli = commontestutils.InitClient(nil)
+			r = &ReconcileAdmissionPolicy{
+				Client: cli,
+				owner:  ref,
+			}
+
+			_, err := r.Reconcile(ctx, startupReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			policies := &admissionv1.ValidatingAdmissionPolicyList{}
+			Expect(cli.List(ctx, policies)).To(Succeed())
+			Expect(policies.Items).To(HaveLen(1))
+			Expect(policies.Items[0].Name).To(Equal(policyName))
+		})
+	})
+})
+
+func reconcileRequest(name string) reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      name,
+			Namespace: commontestutils.Namespace,
+		},
+	}
+}
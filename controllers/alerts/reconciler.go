@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -14,6 +15,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -115,6 +117,16 @@ func (r *MonitoringReconciler) Reconcile(req *common.HcoRequest, firstLoop bool)
 	return nil
 }
 
+// GetTokenRotationCheckInterval returns the maximum interval between reconciliations, that makes sure the bearer
+// token is rotated before it expires. Returns 0 if the reconciler is not initialized.
+func (r *MonitoringReconciler) GetTokenRotationCheckInterval() time.Duration {
+	if r == nil {
+		return 0
+	}
+
+	return authorization.GetTokenRenewBefore() / 2
+}
+
 func (r *MonitoringReconciler) reconcileOneResource(req *common.HcoRequest, reconciler MetricReconciler, firstLoop bool) (client.Object, error) {
 	if r == nil {
 		return nil, nil // not initialized (not running on openshift). do nothing
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -75,26 +76,33 @@ func (r *SecretReconciler) EmptyObject() client.Object {
 	return &corev1.Secret{}
 }
 
-// UpdateExistingResource checks if the secret already exists and has the correct token.
-// If it does, it returns nil. If the secret exists but the token is incorrect, it deletes the old secret
-// and creates a new one with the updated token. If the secret does not exist, it creates a new one.
-// It deletes the old secret to force Prometheus to reload the configuration.
+// UpdateExistingResource checks if the secret already exists and has a valid token, that is not about to expire.
+// If it does, it only reconciles the labels. If the token is not valid, or if it's about to expire, it deletes the
+// old secret and creates a new one with a new token. If the token is about to expire, the signing key is reloaded,
+// and the previous key is still accepted for a grace period, so the scrapes won't fail until Prometheus reloads the
+// new token. It deletes the old secret to force Prometheus to reload the configuration.
 func (r *SecretReconciler) UpdateExistingResource(ctx context.Context, cl client.Client, resource client.Object, logger logr.Logger) (client.Object, bool, error) {
 	found := resource.(*corev1.Secret)
 
 	origLabels := maps.Clone(found.GetLabels())
 
+	currentToken := string(found.Data["token"])
+	if !authorization.ShouldRotateToken(currentToken) {
+		setTokenExpirationMetric(r.secretName, currentToken)
+		return r.onlyReconcileLabels(ctx, cl, found, logger)
+	}
+
+	if valid, _ := authorization.ValidateToken(currentToken); valid {
+		logger.Info("the Secret token is about to expire; reloading the signing key", "namespace", found.Namespace, "name", found.Name)
+		authorization.ReloadSecretKey()
+	}
+
 	token, err := authorization.CreateToken()
 	if err != nil {
 		return nil, false, err
 	}
 
-	// Check if the secret has the correct token
-	if string(found.Data["token"]) == token {
-		return r.onlyReconcileLabels(ctx, cl, found, logger)
-	}
-
-	// If the token is incorrect, delete the old secret and create a new one
+	// If the token is incorrect or about to expire, delete the old secret and create a new one
 	logger.Info("the Secret token is outdated, deleting the old secret and creating a new one", "namespace", found.Namespace, "name", found.Name)
 	if err = cl.Delete(ctx, found); err != nil {
 		if !errors.IsNotFound(err) {
@@ -124,6 +132,9 @@ func (r *SecretReconciler) UpdateExistingResource(ctx context.Context, cl client
 
 	logger.Info("successfully created the new secret", "namespace", sec.GetNamespace(), "name", sec.GetName())
 
+	metrics.IncMetricsTokenRotations(r.secretName)
+	setTokenExpirationMetric(r.secretName, token)
+
 	return sec, true, nil
 }
 
@@ -146,6 +157,16 @@ func (r *SecretReconciler) onlyReconcileLabels(ctx context.Context, cl client.Cl
 	return found, true, nil
 }
 
+func setTokenExpirationMetric(secretName, token string) {
+	expiration, err := authorization.GetTokenExpiration(token)
+	if err != nil {
+		logger.Error(err, "failed to read the bearer token expiration time", "secret", secretName)
+		return
+	}
+
+	metrics.SetMetricsTokenExpiration(secretName, expiration)
+}
+
 func newSecret(namespace string, owner metav1.OwnerReference, token string) *corev1.Secret {
 	return &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
@@ -11,9 +11,13 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
+// serviceAccountTokenFile is the token of the Prometheus ServiceAccount, in the Prometheus pod
+const serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
 type ServiceMonitorReconciler struct {
 	refresher         Refresher
 	theServiceMonitor *monitoringv1.ServiceMonitor
@@ -78,6 +82,26 @@ func (r ServiceMonitorReconciler) deleteServiceMonitor(ctx context.Context, cl c
 	return cl.Delete(ctx, found)
 }
 
+// SetEndpointAuthorization sets how Prometheus authenticates to the metrics endpoint, according to the metrics
+// authorization mode: with the bearer token from the token Secret, or with the Prometheus ServiceAccount token, that
+// is then reviewed by TokenReview and SubjectAccessReview.
+func SetEndpointAuthorization(endpoint *monitoringv1.Endpoint, tokenSecretName string) {
+	if authorization.GetMetricsAuthMode() == authorization.MetricsAuthModeKubernetes {
+		//nolint:staticcheck // ignore SA1019; the Prometheus ServiceAccount token is only available as a file
+		endpoint.BearerTokenFile = serviceAccountTokenFile
+		return
+	}
+
+	endpoint.Authorization = &monitoringv1.SafeAuthorization{
+		Credentials: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: tokenSecretName,
+			},
+			Key: "token",
+		},
+	}
+}
+
 func NewServiceMonitor(namespace string, owner metav1.OwnerReference) *monitoringv1.ServiceMonitor {
 	labels := hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring)
 	spec := monitoringv1.ServiceMonitorSpec{
@@ -88,14 +112,6 @@ func NewServiceMonitor(namespace string, owner metav1.OwnerReference) *monitorin
 			{
 				Port:   OperatorPortName,
 				Scheme: "https",
-				Authorization: &monitoringv1.SafeAuthorization{
-					Credentials: &corev1.SecretKeySelector{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: secretName,
-						},
-						Key: "token",
-					},
-				},
 				TLSConfig: &monitoringv1.TLSConfig{
 					SafeTLSConfig: monitoringv1.SafeTLSConfig{
 						InsecureSkipVerify: ptr.To(true),
@@ -105,6 +121,8 @@ func NewServiceMonitor(namespace string, owner metav1.OwnerReference) *monitorin
 		},
 	}
 
+	SetEndpointAuthorization(&spec.Endpoints[0], secretName)
+
 	return &monitoringv1.ServiceMonitor{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: monitoringv1.SchemeGroupVersion.String(),
@@ -20,6 +20,7 @@ import (
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -181,6 +182,7 @@ func GetScheme() *runtime.Scheme {
 			corev1.AddToScheme,
 			schedulingv1.AddToScheme,
 			admissionregistrationv1.AddToScheme,
+			admissionregistrationv1beta1.AddToScheme,
 		} {
 			if err := f(testScheme); err != nil {
 				panic(fmt.Sprintf("failed to add scheme: %T, %v", f, err))
@@ -270,6 +272,15 @@ func (c ClusterInfoMock) IsDeschedulerAvailable() bool {
 func (c ClusterInfoMock) IsNADAvailable() bool {
 	return true
 }
+func (c ClusterInfoMock) IsMutatingAdmissionPolicyAvailable() bool {
+	return true
+}
+func (c ClusterInfoMock) IsCertManagerAvailable() bool {
+	return true
+}
+func (c ClusterInfoMock) IsBaselineAdminNetworkPolicyAvailable() bool {
+	return true
+}
 func (c ClusterInfoMock) IsDeschedulerCRDDeployed(_ context.Context, _ client.Client) bool {
 	return true
 }
@@ -290,6 +301,12 @@ func (ClusterInfoMock) GetTLSSecurityProfile(_ *openshiftconfigv1.TLSSecurityPro
 func (ClusterInfoMock) RefreshAPIServerCR(_ context.Context, _ client.Client) error {
 	return nil
 }
+func (ClusterInfoMock) GetClusterProxy() *hcoutil.ClusterProxy {
+	return nil
+}
+func (ClusterInfoMock) RefreshProxyCR(_ context.Context, _ client.Client) error {
+	return nil
+}
 
 func KeysFromSSMap(ssmap map[string]string) gstruct.Keys {
 	keys := gstruct.Keys{}
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// baselineAdminNetworkPolicyName is the name of the BaselineAdminNetworkPolicy. The API only allows a single
+// BaselineAdminNetworkPolicy in the cluster, with this name.
+const baselineAdminNetworkPolicyName = "default"
+
+var baselineAdminNetworkPolicyGVK = schema.GroupVersionKind{Group: "policy.networking.k8s.io", Version: "v1alpha1", Kind: "BaselineAdminNetworkPolicy"}
+
+// NewBaselineAdminNetworkPolicyHandler creates the BaselineAdminNetworkPolicy of the virtual machine namespaces, if
+// spec.baselineAdminNetworkPolicy is set, or deletes it otherwise. The network-policy-api is not vendored, so the
+// BaselineAdminNetworkPolicy is handled as an unstructured object.
+func NewBaselineAdminNetworkPolicyHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return operands.NewConditionalHandler(
+		operands.NewGenericOperand(Client, Scheme, "BaselineAdminNetworkPolicy", &baselineAdminNetworkPolicyHooks{}, false),
+		func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.BaselineAdminNetworkPolicy != nil
+		},
+		func(_ *hcov1beta1.HyperConverged) client.Object {
+			return NewBaselineAdminNetworkPolicyWithNameOnly()
+		},
+	)
+}
+
+type baselineAdminNetworkPolicyHooks struct{}
+
+func (*baselineAdminNetworkPolicyHooks) GetFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	return NewBaselineAdminNetworkPolicy(hc)
+}
+
+func (*baselineAdminNetworkPolicyHooks) GetEmptyCr() client.Object {
+	return NewBaselineAdminNetworkPolicyWithNameOnly()
+}
+
+func (*baselineAdminNetworkPolicyHooks) UpdateCR(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	banp, ok1 := required.(*unstructured.Unstructured)
+	found, ok2 := exists.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		return false, false, errors.New("can't convert to BaselineAdminNetworkPolicy")
+	}
+
+	// the BaselineAdminNetworkPolicy is a cluster singleton; don't take over a policy that was created by someone else
+	if found.GetLabels()[hcoutil.AppLabel] != req.Instance.Name {
+		return false, false, fmt.Errorf("the %q BaselineAdminNetworkPolicy already exists, and it is not managed by HCO", found.GetName())
+	}
+
+	if !reflect.DeepEqual(found.Object["spec"], banp.Object["spec"]) || !hcoutil.CompareLabels(banp, found) {
+		if req.HCOTriggered {
+			req.Logger.Info("Updating existing BaselineAdminNetworkPolicy's Spec to new opinionated values")
+		} else {
+			req.Logger.Info("Reconciling an externally updated BaselineAdminNetworkPolicy's Spec to its opinionated values")
+		}
+
+		labels := found.GetLabels()
+		maps.Copy(labels, banp.GetLabels())
+		found.SetLabels(labels)
+		found.Object["spec"] = banp.Object["spec"]
+
+		err := Client.Update(req.Ctx, found)
+		if err != nil {
+			return false, false, err
+		}
+		return true, !req.HCOTriggered, nil
+	}
+
+	return false, false, nil
+}
+
+func NewBaselineAdminNetworkPolicyWithNameOnly() *unstructured.Unstructured {
+	banp := &unstructured.Unstructured{}
+	banp.SetGroupVersionKind(baselineAdminNetworkPolicyGVK)
+	banp.SetName(baselineAdminNetworkPolicyName)
+	return banp
+}
+
+// NewBaselineAdminNetworkPolicy returns the BaselineAdminNetworkPolicy of the namespaces that are selected by
+// spec.baselineAdminNetworkPolicy.namespaceSelector. The policy allows the traffic from and to the HCO namespace,
+// where the KubeVirt components run (virt-handler to virt-launcher, migration, console proxy), and the DNS traffic.
+// Any other traffic from and to the pods in the cluster is denied, unless it is allowed by a NetworkPolicy.
+func NewBaselineAdminNetworkPolicy(hc *hcov1beta1.HyperConverged) (*unstructured.Unstructured, error) {
+	namespaceSelector, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&hc.Spec.BaselineAdminNetworkPolicy.NamespaceSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	banp := NewBaselineAdminNetworkPolicyWithNameOnly()
+	banp.SetLabels(operands.GetLabels(hc, hcoutil.AppComponentNetwork))
+
+	kubevirtPeer := map[string]any{
+		"namespaces": map[string]any{
+			"matchLabels": map[string]any{
+				hcoutil.KubernetesMetadataName: hc.Namespace,
+			},
+		},
+	}
+	allNamespacesPeer := map[string]any{
+		"namespaces": map[string]any{},
+	}
+
+	banp.Object["spec"] = map[string]any{
+		"subject": map[string]any{
+			"namespaces": namespaceSelector,
+		},
+		"ingress": []any{
+			map[string]any{
+				"name":   "allow-from-kubevirt",
+				"action": "Allow",
+				"from":   []any{kubevirtPeer},
+			},
+			map[string]any{
+				"name":   "deny-all-ingress",
+				"action": "Deny",
+				"from":   []any{allNamespacesPeer},
+			},
+		},
+		"egress": []any{
+			map[string]any{
+				"name":   "allow-to-kubevirt",
+				"action": "Allow",
+				"to":     []any{kubevirtPeer},
+			},
+			getBaselineAdminNetworkPolicyDNSRule(),
+			map[string]any{
+				"name":   "deny-all-egress",
+				"action": "Deny",
+				"to":     []any{allNamespacesPeer},
+			},
+		},
+	}
+
+	return banp, nil
+}
+
+func getBaselineAdminNetworkPolicyDNSRule() map[string]any {
+	var (
+		dnsNamespace        = k8sDNSNamespaceSelector
+		dnsPodSelectorLabel = k8sDNSPodSelectorLabel
+		dnsPodSelectorVal   = k8sDNSPodSelectorVal
+		dnsPort             = k8sDNSPort
+	)
+
+	if hcoutil.GetClusterInfo().IsOpenshift() {
+		dnsNamespace = openshiftDNSNamespaceSelector
+		dnsPodSelectorLabel = openshiftDNSPodSelectorLabel
+		dnsPodSelectorVal = openshiftDNSPodSelectorVal
+		dnsPort = openshiftDNSPort
+	}
+
+	return map[string]any{
+		"name":   "allow-to-dns",
+		"action": "Allow",
+		"to": []any{
+			map[string]any{
+				"pods": map[string]any{
+					"namespaceSelector": map[string]any{
+						"matchLabels": map[string]any{
+							hcoutil.KubernetesMetadataName: dnsNamespace,
+						},
+					},
+					"podSelector": map[string]any{
+						"matchLabels": map[string]any{
+							dnsPodSelectorLabel: dnsPodSelectorVal,
+						},
+					},
+				},
+			},
+		},
+		"ports": []any{
+			map[string]any{
+				"portNumber": map[string]any{"protocol": string(corev1.ProtocolUDP), "port": int64(dnsPort)},
+			},
+			map[string]any{
+				"portNumber": map[string]any{"protocol": string(corev1.ProtocolTCP), "port": int64(dnsPort)},
+			},
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("BaselineAdminNetworkPolicy", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	getBANP := func(cli client.Client) (*unstructured.Unstructured, error) {
+		banp := NewBaselineAdminNetworkPolicyWithNameOnly()
+		err := cli.Get(context.TODO(), client.ObjectKeyFromObject(banp), banp)
+		return banp, err
+	}
+
+	vmNamespaces := func() *hcov1beta1.BaselineAdminNetworkPolicyConfig {
+		return &hcov1beta1.BaselineAdminNetworkPolicyConfig{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"vms": "true"}},
+		}
+	}
+
+	It("should not create the BaselineAdminNetworkPolicy if it is not configured", func() {
+		cli := commontestutils.InitClient([]client.Object{hco})
+		res := NewBaselineAdminNetworkPolicyHandler(cli, commontestutils.GetScheme()).Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Created).To(BeFalse())
+
+		_, err := getBANP(cli)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should create the BaselineAdminNetworkPolicy for the selected namespaces", func() {
+		hco.Spec.BaselineAdminNetworkPolicy = vmNamespaces()
+		cli := commontestutils.InitClient([]client.Object{hco})
+
+		res := NewBaselineAdminNetworkPolicyHandler(cli, commontestutils.GetScheme()).Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Created).To(BeTrue())
+
+		banp, err := getBANP(cli)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(banp.GetName()).To(Equal("default"))
+		Expect(banp.GetLabels()).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+
+		subject, _, _ := unstructured.NestedStringMap(banp.Object, "spec", "subject", "namespaces", "matchLabels")
+		Expect(subject).To(Equal(map[string]string{"vms": "true"}))
+
+		ingress, _, _ := unstructured.NestedSlice(banp.Object, "spec", "ingress")
+		Expect(ruleNamesAndActions(ingress)).To(Equal([]string{"allow-from-kubevirt:Allow", "deny-all-ingress:Deny"}))
+
+		egress, _, _ := unstructured.NestedSlice(banp.Object, "spec", "egress")
+		Expect(ruleNamesAndActions(egress)).To(Equal([]string{"allow-to-kubevirt:Allow", "allow-to-dns:Allow", "deny-all-egress:Deny"}))
+
+		kubevirtNamespace, _, _ := unstructured.NestedString(ingress[0].(map[string]any)["from"].([]any)[0].(map[string]any), "namespaces", "matchLabels", hcoutil.KubernetesMetadataName)
+		Expect(kubevirtNamespace).To(Equal(hco.Namespace))
+	})
+
+	It("should update the namespace selector, and remove the BaselineAdminNetworkPolicy when it is not configured anymore", func() {
+		hco.Spec.BaselineAdminNetworkPolicy = vmNamespaces()
+		existing, err := NewBaselineAdminNetworkPolicy(hco)
+		Expect(err).ToNot(HaveOccurred())
+		cli := commontestutils.InitClient([]client.Object{hco, existing})
+		handler := NewBaselineAdminNetworkPolicyHandler(cli, commontestutils.GetScheme())
+
+		hco.Spec.BaselineAdminNetworkPolicy.NamespaceSelector.MatchLabels = map[string]string{"tenant": "vms"}
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeTrue())
+
+		banp, err := getBANP(cli)
+		Expect(err).ToNot(HaveOccurred())
+		subject, _, _ := unstructured.NestedStringMap(banp.Object, "spec", "subject", "namespaces", "matchLabels")
+		Expect(subject).To(Equal(map[string]string{"tenant": "vms"}))
+
+		hco.Spec.BaselineAdminNetworkPolicy = nil
+		res = handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Deleted).To(BeTrue())
+
+		_, err = getBANP(cli)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not take over a BaselineAdminNetworkPolicy that is not managed by HCO", func() {
+		hco.Spec.BaselineAdminNetworkPolicy = vmNamespaces()
+		existing := NewBaselineAdminNetworkPolicyWithNameOnly()
+		existing.Object["spec"] = map[string]any{
+			"subject": map[string]any{"namespaces": map[string]any{}},
+		}
+		cli := commontestutils.InitClient([]client.Object{hco, existing})
+
+		res := NewBaselineAdminNetworkPolicyHandler(cli, commontestutils.GetScheme()).Ensure(req)
+		Expect(res.Err).To(MatchError(ContainSubstring("is not managed by HCO")))
+
+		banp, err := getBANP(cli)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(banp.Object["spec"]).To(Equal(existing.Object["spec"]))
+	})
+})
+
+func ruleNamesAndActions(rules []any) []string {
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		r := rule.(map[string]any)
+		names = append(names, r["name"].(string)+":"+r["action"].(string))
+	}
+	return names
+}
@@ -15,6 +15,7 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/reformatobj"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -95,8 +96,9 @@ func (*cdiHooks) UpdateCR(req *common.HcoRequest, Client client.Client, exists r
 	return false, false, nil
 }
 
-func getDefaultFeatureGates() []string {
-	return []string{honorWaitForFirstConsumerGate, dataVolumeClaimAdoptionGate, webhookPvcRenderingGate}
+func getDefaultFeatureGates(hc *hcov1beta1.HyperConverged) []string {
+	fgs := []string{honorWaitForFirstConsumerGate, dataVolumeClaimAdoptionGate, webhookPvcRenderingGate}
+	return append(fgs, featuregates.CDIFeatureGates(&hc.Spec.FeatureGates)...)
 }
 
 func NewCDI(hc *hcov1beta1.HyperConverged, opts ...string) (*cdiv1beta1.CDI, error) {
@@ -108,8 +110,9 @@ func NewCDI(hc *hcov1beta1.HyperConverged, opts ...string) (*cdiv1beta1.CDI, err
 	spec := cdiv1beta1.CDISpec{
 		UninstallStrategy: &uninstallStrategy,
 		Config: &cdiv1beta1.CDIConfigSpec{
-			FeatureGates:       getDefaultFeatureGates(),
+			FeatureGates:       getDefaultFeatureGates(hc),
 			TLSSecurityProfile: openshift2CdiSecProfile(util.GetClusterInfo().GetTLSSecurityProfile(hc.Spec.TLSSecurityProfile)),
+			ImagePullSecrets:   operands.GetImagePullSecrets(hc),
 		},
 		CertConfig: &cdiv1beta1.CDICertConfig{
 			CA: &cdiv1beta1.CertConfig{
@@ -142,6 +145,8 @@ func NewCDI(hc *hcov1beta1.HyperConverged, opts ...string) (*cdiv1beta1.CDI, err
 		}
 	}
 
+	spec.Config.ImportProxy = GetImportProxy(hc)
+
 	if hc.Spec.Infra.NodePlacement != nil {
 		hc.Spec.Infra.NodePlacement.DeepCopyInto(&spec.Infra.NodePlacement)
 	}
@@ -186,11 +191,16 @@ func openshift2CdiSecProfile(hcProfile *openshiftconfigv1.TLSSecurityProfile) *c
 		}
 	}
 
+	modern := (*cdiv1beta1.ModernTLSProfile)(hcProfile.Modern)
+	if hcProfile.Type == openshiftconfigv1.TLSProfileModernType && modern == nil {
+		modern = &cdiv1beta1.ModernTLSProfile{}
+	}
+
 	return &cdiv1beta1.TLSSecurityProfile{
 		Type:         cdiv1beta1.TLSProfileType(hcProfile.Type),
 		Old:          (*cdiv1beta1.OldTLSProfile)(hcProfile.Old),
 		Intermediate: (*cdiv1beta1.IntermediateTLSProfile)(hcProfile.Intermediate),
-		Modern:       (*cdiv1beta1.ModernTLSProfile)(hcProfile.Modern),
+		Modern:       modern,
 		Custom:       custom,
 	}
 }
@@ -1329,6 +1329,15 @@ var _ = Describe("CDI Operand", func() {
 				Expect(req.Conditions).To(BeEmpty())
 			})
 
+			It("should set the modern profile on the CDI CR, if only the modern type is set", func() {
+				cdiProfile := openshift2CdiSecProfile(&openshiftconfigv1.TLSSecurityProfile{
+					Type: openshiftconfigv1.TLSProfileModernType,
+				})
+
+				Expect(cdiProfile.Type).To(Equal(cdiv1beta1.TLSProfileModernType))
+				Expect(cdiProfile.Modern).ToNot(BeNil())
+			})
+
 			It("should overwrite TLSSecurityProfile if directly set on CDI CR", func() {
 				hco.Spec.TLSSecurityProfile = intermediateTLSSecurityProfile
 				existingResource, err := NewCDI(hco)
@@ -0,0 +1,223 @@
+package golden_images
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const (
+	// DICTCatalogueLabel marks a ConfigMap in the HCO namespace as an external catalogue of common
+	// DataImportCronTemplates. The ConfigMap must also be labeled with app=kubevirt-hyperconverged.
+	DICTCatalogueLabel = "hco.kubevirt.io/dataimportcrontemplate-catalogue"
+
+	// DICTCatalogueRevisionAnnotation optionally holds the revision of the external catalogue
+	DICTCatalogueRevisionAnnotation = "hco.kubevirt.io/catalogue-revision"
+
+	// DICTCatalogueDataKey is the key of the DataImportCronTemplate list, in the catalogue ConfigMap data
+	DICTCatalogueDataKey = "dataImportCronTemplates.yaml"
+)
+
+var (
+	// dataImportCronTemplateCatalogueMap is the set of the common data import cron templates that were loaded from the
+	// last valid revision of the external catalogue. The catalogue templates are merged with the hard-coded ones; if
+	// both contain a template with the same name, the catalogue template is used.
+	dataImportCronTemplateCatalogueMap map[string]hcov1beta1.DataImportCronTemplate
+
+	// catalogueStatus describes the last valid revision of the external catalogue
+	catalogueStatus *hcov1beta1.DataImportCronTemplatesCatalogueStatus
+
+	// catalogueKey identifies the ConfigMap and the data of the last valid revision of the external catalogue
+	catalogueKey string
+
+	// rejectedCatalogueKey and rejectedCatalogueErr identify the last rejected catalogue, and the reason it was
+	// rejected
+	rejectedCatalogueKey string
+	rejectedCatalogueErr error
+)
+
+// LoadDataImportCronTemplatesCatalogue validates the external catalogue ConfigMap, and if it is valid, merges its
+// DataImportCronTemplates with the hard-coded common DataImportCronTemplates. If the catalogue is not valid, the last
+// valid revision is kept in effect.
+//
+// The function returns the catalogue status to be set in the HyperConverged status, and whether the common
+// DataImportCronTemplates were changed.
+func LoadDataImportCronTemplatesCatalogue(cms []corev1.ConfigMap) (*hcov1beta1.DataImportCronTemplatesCatalogueStatus, bool) {
+	if len(cms) == 0 {
+		changed := dataImportCronTemplateCatalogueMap != nil
+		dataImportCronTemplateCatalogueMap = nil
+		catalogueStatus = nil
+		catalogueKey = ""
+		rejectedCatalogueKey = ""
+		rejectedCatalogueErr = nil
+		return nil, changed
+	}
+
+	if len(cms) > 1 {
+		names := make([]string, 0, len(cms))
+		for _, cm := range cms {
+			names = append(names, cm.Name)
+		}
+		slices.Sort(names)
+		key := strings.Join(names, ", ")
+		if key == rejectedCatalogueKey {
+			return rejectedCatalogueStatus("", rejectedCatalogueErr), false
+		}
+
+		return rejectCatalogue(key, "", fmt.Errorf("found more than one catalogue ConfigMap: %s", key)), false
+	}
+
+	cm := cms[0]
+	dataHash := getCatalogueDataHash(cm)
+	revision := cmp.Or(cm.Annotations[DICTCatalogueRevisionAnnotation], dataHash)
+	key := cm.Name + "/" + revision + "/" + dataHash
+
+	if catalogueStatus != nil && catalogueKey == key {
+		return catalogueStatus.DeepCopy(), false
+	}
+
+	if key == rejectedCatalogueKey {
+		return rejectedCatalogueStatus(cm.Name, rejectedCatalogueErr), false
+	}
+
+	dicts, err := parseDataImportCronTemplatesCatalogue(cm)
+	if err != nil {
+		return rejectCatalogue(key, cm.Name, err), false
+	}
+
+	catalogueMap := make(map[string]hcov1beta1.DataImportCronTemplate, len(dicts))
+	for _, dict := range dicts {
+		if commonDictsSchedule != "" {
+			dict.Spec.Schedule = commonDictsSchedule
+		}
+		catalogueMap[dict.Name] = dict
+	}
+
+	dataImportCronTemplateCatalogueMap = catalogueMap
+	catalogueStatus = &hcov1beta1.DataImportCronTemplatesCatalogueStatus{
+		ConfigMapName:           cm.Name,
+		Revision:                revision,
+		DataImportCronTemplates: slices.Sorted(maps.Keys(catalogueMap)),
+	}
+	catalogueKey = key
+
+	return catalogueStatus.DeepCopy(), true
+}
+
+// rejectCatalogue logs the validation error, and remembers the rejected catalogue, so it is not parsed and logged
+// again until it is modified
+func rejectCatalogue(key, cmName string, err error) *hcov1beta1.DataImportCronTemplatesCatalogueStatus {
+	logger.Error(err, "rejecting the DataImportCronTemplate catalogue", "ConfigMap", cmName)
+	rejectedCatalogueKey = key
+	rejectedCatalogueErr = err
+
+	return rejectedCatalogueStatus(cmName, err)
+}
+
+// getCatalogueDataHash returns the SHA-256 hash of the catalogue data, or an empty string if the data key is missing
+func getCatalogueDataHash(cm corev1.ConfigMap) string {
+	data, found := cm.Data[DICTCatalogueDataKey]
+	if !found {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:])
+}
+
+func rejectedCatalogueStatus(cmName string, err error) *hcov1beta1.DataImportCronTemplatesCatalogueStatus {
+	status := &hcov1beta1.DataImportCronTemplatesCatalogueStatus{}
+	if catalogueStatus != nil {
+		status = catalogueStatus.DeepCopy()
+	}
+
+	status.ValidationError = err.Error()
+	if status.ConfigMapName == "" {
+		status.ConfigMapName = cmName
+	}
+
+	return status
+}
+
+func parseDataImportCronTemplatesCatalogue(cm corev1.ConfigMap) ([]hcov1beta1.DataImportCronTemplate, error) {
+	data, found := cm.Data[DICTCatalogueDataKey]
+	if !found {
+		return nil, fmt.Errorf("the %s key is missing in the %s ConfigMap", DICTCatalogueDataKey, cm.Name)
+	}
+
+	var dicts []hcov1beta1.DataImportCronTemplate
+	if err := yaml.UnmarshalStrict([]byte(data), &dicts); err != nil {
+		return nil, fmt.Errorf("failed to parse the DataImportCronTemplate catalogue; %w", err)
+	}
+
+	if err := validateCatalogueDicts(dicts); err != nil {
+		return nil, err
+	}
+
+	return dicts, nil
+}
+
+func validateCatalogueDicts(dicts []hcov1beta1.DataImportCronTemplate) error {
+	var errs []error
+	names := make(map[string]bool, len(dicts))
+
+	for i, dict := range dicts {
+		if dict.Name == "" {
+			errs = append(errs, fmt.Errorf("DataImportCronTemplate #%d: missing name", i))
+			continue
+		}
+
+		if names[dict.Name] {
+			errs = append(errs, fmt.Errorf("duplicate DataImportCronTemplate found: %s", dict.Name))
+			continue
+		}
+		names[dict.Name] = true
+
+		if msgs := validation.IsDNS1123Subdomain(dict.Name); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("DataImportCronTemplate %s: invalid name: %s", dict.Name, strings.Join(msgs, "; ")))
+		}
+
+		if dict.Spec == nil {
+			errs = append(errs, fmt.Errorf("DataImportCronTemplate %s: missing spec", dict.Name))
+			continue
+		}
+
+		if dict.Spec.ManagedDataSource == "" {
+			errs = append(errs, fmt.Errorf("DataImportCronTemplate %s: missing spec.managedDataSource", dict.Name))
+		}
+
+		if source := dict.Spec.Template.Spec.Source; source == nil || source.Registry == nil ||
+			(source.Registry.URL == nil && source.Registry.ImageStream == nil) {
+			errs = append(errs, fmt.Errorf("DataImportCronTemplate %s: missing registry source", dict.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// getCommonDictTemplates returns the hard-coded common DataImportCronTemplates, merged with the ones from the
+// external catalogue
+func getCommonDictTemplates() map[string]hcov1beta1.DataImportCronTemplate {
+	if len(dataImportCronTemplateCatalogueMap) == 0 {
+		return dataImportCronTemplateHardCodedMap
+	}
+
+	commonDicts := maps.Clone(dataImportCronTemplateHardCodedMap)
+	if commonDicts == nil {
+		commonDicts = make(map[string]hcov1beta1.DataImportCronTemplate, len(dataImportCronTemplateCatalogueMap))
+	}
+	maps.Copy(commonDicts, dataImportCronTemplateCatalogueMap)
+
+	return commonDicts
+}
@@ -0,0 +1,249 @@
+package golden_images
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Test the DataImportCronTemplate catalogue", func() {
+	const (
+		validCatalogue = `- metadata:
+    name: centos-stream10-image-cron
+  spec:
+    schedule: "0 */12 * * *"
+    template:
+      spec:
+        source:
+          registry:
+            url: docker://quay.io/containerdisks/centos-stream:10
+        storage:
+          resources:
+            requests:
+              storage: 30Gi
+    garbageCollect: Outdated
+    managedDataSource: centos-stream10
+- metadata:
+    name: image1
+  spec:
+    schedule: "0 */12 * * *"
+    template:
+      spec:
+        source:
+          registry:
+            url: docker://someregistry/new-image1
+    managedDataSource: image1
+`
+		invalidCatalogue = `- metadata:
+    name: no-source
+  spec:
+    managedDataSource: no-source
+- metadata:
+    name: no-spec
+`
+	)
+
+	newCatalogueCM := func(data, revision string) corev1.ConfigMap {
+		cm := corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "my-catalogue",
+				Namespace:       commontestutils.Namespace,
+				ResourceVersion: "1234",
+				Labels: map[string]string{
+					DICTCatalogueLabel: "true",
+				},
+			},
+			Data: map[string]string{
+				DICTCatalogueDataKey: data,
+			},
+		}
+
+		if revision != "" {
+			cm.Annotations = map[string]string{DICTCatalogueRevisionAnnotation: revision}
+		}
+
+		return cm
+	}
+
+	BeforeEach(func() {
+		origHardCodedMap := dataImportCronTemplateHardCodedMap
+		origSchedule := commonDictsSchedule
+
+		image1, _ := makeDICT(1, true)
+		dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{image1.Name: image1}
+
+		DeferCleanup(func() {
+			dataImportCronTemplateHardCodedMap = origHardCodedMap
+			commonDictsSchedule = origSchedule
+			dataImportCronTemplateCatalogueMap = nil
+			catalogueStatus = nil
+			catalogueKey = ""
+			rejectedCatalogueKey = ""
+			rejectedCatalogueErr = nil
+		})
+	})
+
+	It("should do nothing if there is no catalogue", func() {
+		status, changed := LoadDataImportCronTemplatesCatalogue(nil)
+		Expect(status).To(BeNil())
+		Expect(changed).To(BeFalse())
+		Expect(getCommonDictTemplates()).To(HaveLen(1))
+	})
+
+	It("should merge a valid catalogue with the hard-coded templates", func() {
+		status, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue, "v1")})
+		Expect(changed).To(BeTrue())
+		Expect(status).ToNot(BeNil())
+		Expect(status.ConfigMapName).To(Equal("my-catalogue"))
+		Expect(status.Revision).To(Equal("v1"))
+		Expect(status.DataImportCronTemplates).To(Equal([]string{"centos-stream10-image-cron", "image1"}))
+		Expect(status.ValidationError).To(BeEmpty())
+
+		commonDicts := getCommonDictTemplates()
+		Expect(commonDicts).To(HaveLen(2))
+		Expect(commonDicts).To(HaveKey("centos-stream10-image-cron"))
+		Expect(commonDicts["image1"].Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal("docker://someregistry/new-image1")))
+
+		By("should not report a change if the revision was not changed")
+		_, changed = LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue, "v1")})
+		Expect(changed).To(BeFalse())
+
+		By("should drop the catalogue templates when the catalogue is removed")
+		status, changed = LoadDataImportCronTemplatesCatalogue(nil)
+		Expect(changed).To(BeTrue())
+		Expect(status).To(BeNil())
+		Expect(getCommonDictTemplates()).To(HaveLen(1))
+	})
+
+	It("should use the hash of the data as the revision, if the annotation is missing", func() {
+		status, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue, "")})
+		Expect(changed).To(BeTrue())
+		Expect(status.Revision).To(MatchRegexp("^[0-9a-f]{64}$"))
+
+		By("should not report a change if only the resourceVersion was changed")
+		cm := newCatalogueCM(validCatalogue, "")
+		cm.ResourceVersion = "5678"
+		newStatus, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{cm})
+		Expect(changed).To(BeFalse())
+		Expect(newStatus.Revision).To(Equal(status.Revision))
+	})
+
+	It("should reload the catalogue if its data was modified without modifying the revision annotation", func() {
+		_, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue, "v1")})
+		Expect(changed).To(BeTrue())
+		Expect(getCommonDictTemplates()).To(HaveKey("centos-stream10-image-cron"))
+
+		status, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue[:strings.Index(validCatalogue, "- metadata:\n    name: image1")], "v1")})
+		Expect(changed).To(BeTrue())
+		Expect(status.Revision).To(Equal("v1"))
+		Expect(status.DataImportCronTemplates).To(Equal([]string{"centos-stream10-image-cron"}))
+	})
+
+	It("should apply the common schedule on the catalogue templates", func() {
+		overrideDataImportSchedule("42 5/12 * * *")
+
+		_, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue, "v1")})
+		Expect(changed).To(BeTrue())
+		Expect(getCommonDictTemplates()["centos-stream10-image-cron"].Spec.Schedule).To(Equal("42 5/12 * * *"))
+	})
+
+	It("should reject an invalid catalogue, and keep the last valid revision", func() {
+		_, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue, "v1")})
+		Expect(changed).To(BeTrue())
+
+		status, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(invalidCatalogue, "v2")})
+		Expect(changed).To(BeFalse())
+		Expect(status.Revision).To(Equal("v1"))
+		Expect(status.ValidationError).To(ContainSubstring("no-source: missing registry source"))
+		Expect(status.ValidationError).To(ContainSubstring("no-spec: missing spec"))
+		Expect(getCommonDictTemplates()).To(HaveKey("centos-stream10-image-cron"))
+	})
+
+	It("should remember the rejected catalogue, and only parse it again when its data is modified", func() {
+		status, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(invalidCatalogue, "v1")})
+		Expect(changed).To(BeFalse())
+		Expect(status.ValidationError).To(ContainSubstring("no-spec: missing spec"))
+		rejectedKey := rejectedCatalogueKey
+		Expect(rejectedKey).ToNot(BeEmpty())
+
+		By("should return the same rejection, without parsing the catalogue again")
+		rejectedCatalogueErr = errors.New("cached rejection")
+		status, changed = LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(invalidCatalogue, "v1")})
+		Expect(changed).To(BeFalse())
+		Expect(status.ValidationError).To(Equal("cached rejection"))
+		Expect(rejectedCatalogueKey).To(Equal(rejectedKey))
+
+		By("should parse the catalogue again when its data is modified")
+		status, changed = LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue, "v1")})
+		Expect(changed).To(BeTrue())
+		Expect(status.ValidationError).To(BeEmpty())
+	})
+
+	It("should reject a catalogue with unknown fields", func() {
+		status, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM("- metadata:\n    name: a\n  spek: {}\n", "v1")})
+		Expect(changed).To(BeFalse())
+		Expect(status.Revision).To(BeEmpty())
+		Expect(status.ValidationError).To(ContainSubstring("failed to parse"))
+	})
+
+	It("should reject a catalogue ConfigMap without the data key", func() {
+		cm := newCatalogueCM(validCatalogue, "v1")
+		cm.Data = nil
+
+		status, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{cm})
+		Expect(changed).To(BeFalse())
+		Expect(status.ConfigMapName).To(Equal("my-catalogue"))
+		Expect(status.ValidationError).To(ContainSubstring(DICTCatalogueDataKey))
+	})
+
+	It("should reject more than one catalogue ConfigMap", func() {
+		cm1 := newCatalogueCM(validCatalogue, "v1")
+		cm2 := newCatalogueCM(validCatalogue, "v1")
+		cm2.Name = "other-catalogue"
+
+		status, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{cm1, cm2})
+		Expect(changed).To(BeFalse())
+		Expect(status.ValidationError).To(ContainSubstring("more than one catalogue ConfigMap"))
+	})
+
+	It("should treat the catalogue templates as common templates", func() {
+		_, changed := LoadDataImportCronTemplatesCatalogue([]corev1.ConfigMap{newCatalogueCM(validCatalogue, "v1")})
+		Expect(changed).To(BeTrue())
+
+		hco := commontestutils.NewHco()
+		hco.Spec.EnableCommonBootImageImport = ptr.To(true)
+		hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "centos-stream10-image-cron"},
+				Spec: &cdiv1beta1.DataImportCronSpec{
+					Template: cdiv1beta1.DataVolume{
+						Spec: cdiv1beta1.DataVolumeSpec{
+							Source: &cdiv1beta1.DataVolumeSource{
+								Registry: &cdiv1beta1.DataVolumeSourceRegistry{URL: ptr.To("docker://mirror/centos-stream:10")},
+							},
+						},
+					},
+					ManagedDataSource: "centos-stream10",
+				},
+			},
+		}
+
+		dicts, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dicts).To(HaveLen(2))
+		for _, dict := range dicts {
+			Expect(dict.Status.CommonTemplate).To(BeTrue())
+		}
+		Expect(dicts[0].Name).To(Equal("centos-stream10-image-cron"))
+		Expect(dicts[0].Status.Modified).To(BeTrue())
+	})
+})
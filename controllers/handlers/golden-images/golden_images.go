@@ -44,6 +44,9 @@ var (
 	// of data import cron templates from a local file and updates SSP with the up-to-date list
 	dataImportCronTemplateHardCodedMap map[string]hcov1beta1.DataImportCronTemplate
 
+	// commonDictsSchedule is the cron expression that is used for the common data import cron templates, if set
+	commonDictsSchedule string
+
 	logger = logf.Log.WithName("dataImportCronTemplateInit")
 )
 
@@ -165,7 +168,10 @@ func readDataImportCronTemplatesFromFile(wdFS fs.FS) error {
 
 func getCommonDicts(list []hcov1beta1.DataImportCronTemplateStatus, crDicts map[string]hcov1beta1.DataImportCronTemplate, hc *hcov1beta1.HyperConverged) []hcov1beta1.DataImportCronTemplateStatus {
 	enableMultiArchBootImageImport := ptr.Deref(hc.Spec.FeatureGates.EnableMultiArchBootImageImport, false)
-	for dictName, commonDict := range dataImportCronTemplateHardCodedMap {
+	registryMirrors := getRegistryMirrors(hc)
+	commonDicts := getCommonDictTemplates()
+	windowSchedules := getWindowSchedules(hc.Spec.DataImportScheduleWindow, slices.Sorted(maps.Keys(commonDicts)))
+	for dictName, commonDict := range commonDicts {
 		targetDict := hcov1beta1.DataImportCronTemplateStatus{
 			DataImportCronTemplate: *commonDict.DeepCopy(),
 			Status: hcov1beta1.DataImportCronStatus{
@@ -173,6 +179,10 @@ func getCommonDicts(list []hcov1beta1.DataImportCronTemplateStatus, crDicts map[
 			},
 		}
 
+		if schedule, found := windowSchedules[dictName]; found {
+			targetDict.Spec.Schedule = schedule
+		}
+
 		if crDict, found := crDicts[dictName]; found {
 			if !customizeCommonDICT(&targetDict, crDict, enableMultiArchBootImageImport) {
 				continue
@@ -181,6 +191,9 @@ func getCommonDicts(list []hcov1beta1.DataImportCronTemplateStatus, crDicts map[
 			targetDict.Namespace = *ns
 		}
 
+		// rewriting the registry URL to a mirror does not mark the template as modified
+		applyRegistryMirrors(&targetDict, registryMirrors)
+
 		list = append(list, targetDict)
 	}
 
@@ -192,14 +205,17 @@ func customizeCommonDICT(targetDict *hcov1beta1.DataImportCronTemplateStatus, cr
 		return false
 	}
 
+	// copying the spec, in order not to modify the HyperConverged CR
+	crDictSpec := crDict.Spec.DeepCopy()
+
 	// if the schedule is missing, copy from the common dict:
-	if len(crDict.Spec.Schedule) == 0 {
-		crDict.Spec.Schedule = targetDict.Spec.Schedule
+	if len(crDictSpec.Schedule) == 0 {
+		crDictSpec.Schedule = targetDict.Spec.Schedule
 	}
 
 	customizeCommonDictAnnotations(targetDict, crDict, enableMultiArchBootImageImport)
 
-	targetDict.Spec = crDict.Spec.DeepCopy()
+	targetDict.Spec = crDictSpec
 	targetDict.Namespace = crDict.Namespace
 	targetDict.Status.Modified = true
 
@@ -261,12 +277,13 @@ func isDataImportCronTemplateEnabled(dict hcov1beta1.DataImportCronTemplate) boo
 }
 
 func getCustomDicts(list []hcov1beta1.DataImportCronTemplateStatus, crDicts map[string]hcov1beta1.DataImportCronTemplate) []hcov1beta1.DataImportCronTemplateStatus {
+	commonDicts := getCommonDictTemplates()
 	for dictName, crDict := range crDicts {
 		if !isDataImportCronTemplateEnabled(crDict) {
 			continue
 		}
 
-		if _, isCommon := dataImportCronTemplateHardCodedMap[dictName]; !isCommon {
+		if _, isCommon := commonDicts[dictName]; !isCommon {
 			list = append(list, hcov1beta1.DataImportCronTemplateStatus{
 				DataImportCronTemplate: *crDict.DeepCopy(),
 				Status: hcov1beta1.DataImportCronStatus{
@@ -292,10 +309,14 @@ func getDicMapFromCr(hc *hcov1beta1.HyperConverged) (map[string]hcov1beta1.DataI
 }
 
 func overrideDataImportSchedule(schedule string) {
-	for dictName := range dataImportCronTemplateHardCodedMap {
-		dict := dataImportCronTemplateHardCodedMap[dictName]
-		dict.Spec.Schedule = schedule
-		dataImportCronTemplateHardCodedMap[dictName] = dict
+	commonDictsSchedule = schedule
+
+	for _, dictMap := range []map[string]hcov1beta1.DataImportCronTemplate{dataImportCronTemplateHardCodedMap, dataImportCronTemplateCatalogueMap} {
+		for dictName := range dictMap {
+			dict := dictMap[dictName]
+			dict.Spec.Schedule = schedule
+			dictMap[dictName] = dict
+		}
 	}
 }
 
@@ -0,0 +1,151 @@
+package golden_images
+
+import (
+	"cmp"
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+const (
+	DictConditionUpToDateType         = "UpToDate"
+	DictConditionProgressingType      = "Progressing"
+	DictConditionDataSourceReadyType  = "DataSourceReady"
+	dictConditionDefaultReason        = "Unknown"
+	dataImportCronDesiredDigestAnnKey = "cdi.kubevirt.io/storage.import.sourceDesiredDigest"
+
+	// the namespace that SSP uses for the DataImportCrons, if the DataImportCronTemplate does not specify one. The
+	// common DataImportCronTemplates already have the namespace of spec.commonBootImageNamespace, if it is set.
+	defaultGoldenImagesNamespace = "kubevirt-os-images"
+)
+
+var importConditionTypes = []string{
+	DictConditionUpToDateType,
+	DictConditionProgressingType,
+	DictConditionDataSourceReadyType,
+}
+
+// SetDataImportCronTemplatesImportStatus reads the DataImportCron and the DataSource that SSP created for each one of
+// the DataImportCronTemplates, and folds their state into the DataImportCronTemplate status. prevDicts is the
+// current HyperConverged status, and it is used to keep the condition transition times.
+func SetDataImportCronTemplatesImportStatus(ctx context.Context, cl client.Reader, dicts, prevDicts []hcov1beta1.DataImportCronTemplateStatus) error {
+	prevConditions := make(map[string][]metav1.Condition, len(prevDicts))
+	for _, prev := range prevDicts {
+		prevConditions[prev.Name] = prev.Status.Conditions
+	}
+
+	for i := range dicts {
+		if err := setDICTImportStatus(ctx, cl, &dicts[i], prevConditions[dicts[i].Name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setDICTImportStatus(ctx context.Context, cl client.Reader, dict *hcov1beta1.DataImportCronTemplateStatus, prevConditions []metav1.Condition) error {
+	dictStatus := &dict.Status
+	for _, condType := range importConditionTypes {
+		meta.RemoveStatusCondition(&dictStatus.Conditions, condType)
+	}
+	dictStatus.LastImportTimestamp = nil
+	dictStatus.CurrentDigest = ""
+
+	if meta.IsStatusConditionFalse(dictStatus.Conditions, DictConditionDeployedType) {
+		// not deployed by SSP; nothing to report
+		return nil
+	}
+
+	namespace := getDataImportCronNamespace(dict)
+
+	dic := &cdiv1beta1.DataImportCron{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: dict.Name}, dic); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	dictStatus.LastImportTimestamp = dic.Status.LastImportTimestamp.DeepCopy()
+	dictStatus.CurrentDigest = dic.Annotations[dataImportCronDesiredDigestAnnKey]
+
+	for _, cond := range dic.Status.Conditions {
+		switch cond.Type {
+		case cdiv1beta1.DataImportCronUpToDate, cdiv1beta1.DataImportCronProgressing:
+			setImportCondition(dictStatus, string(cond.Type), cond.ConditionState, prevConditions)
+		}
+	}
+
+	if dict.Spec != nil && dict.Spec.ManagedDataSource != "" {
+		ds := &cdiv1beta1.DataSource{}
+		err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: dict.Spec.ManagedDataSource}, ds)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if err == nil {
+			for _, cond := range ds.Status.Conditions {
+				if cond.Type == cdiv1beta1.DataSourceReady {
+					setImportCondition(dictStatus, DictConditionDataSourceReadyType, cond.ConditionState, prevConditions)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func setImportCondition(dictStatus *hcov1beta1.DataImportCronStatus, condType string, state cdiv1beta1.ConditionState, prevConditions []metav1.Condition) {
+	cond := metav1.Condition{
+		Type:               condType,
+		Status:             metav1.ConditionStatus(state.Status),
+		Reason:             cmp.Or(state.Reason, dictConditionDefaultReason),
+		Message:            state.Message,
+		LastTransitionTime: state.LastTransitionTime,
+	}
+
+	// keep the transition time from the previous reconciliation, if the status was not changed
+	if prev := meta.FindStatusCondition(prevConditions, condType); prev != nil && prev.Status == cond.Status {
+		cond.LastTransitionTime = prev.LastTransitionTime
+	}
+
+	meta.SetStatusCondition(&dictStatus.Conditions, cond)
+}
+
+func getDataImportCronNamespace(dict *hcov1beta1.DataImportCronTemplateStatus) string {
+	if dict.Namespace != "" {
+		return dict.Namespace
+	}
+
+	return defaultGoldenImagesNamespace
+}
+
+// SetDataImportCronTemplatesImportMetrics sets the golden images freshness metrics, according to the import status
+// of the DataImportCronTemplates
+func SetDataImportCronTemplatesImportMetrics(dicts []hcov1beta1.DataImportCronTemplateStatus) {
+	metrics.ResetDICTImportMetrics()
+
+	for _, dict := range dicts {
+		dsName := ""
+		if dict.Spec != nil {
+			dsName = dict.Spec.ManagedDataSource
+		}
+
+		if dict.Status.LastImportTimestamp != nil {
+			metrics.SetDICTLastImportTimestamp(dict.Name, dsName, dict.Status.LastImportTimestamp.Time)
+		}
+
+		if upToDate := meta.FindStatusCondition(dict.Status.Conditions, DictConditionUpToDateType); upToDate != nil {
+			metrics.SetDICTUpToDate(dict.Name, dsName, upToDate.Status == metav1.ConditionTrue)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package golden_images
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+var _ = Describe("Test the DataImportCronTemplate import status", func() {
+	const (
+		dictName = "fedora-image-cron"
+		dsName   = "fedora"
+		digest   = "sha256:1234567890abcdef"
+	)
+
+	var (
+		lastImport     = metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		transitionTime = metav1.NewTime(time.Now().Add(-2 * time.Hour).Truncate(time.Second))
+	)
+
+	newDictStatus := func(namespace string) []hcov1beta1.DataImportCronTemplateStatus {
+		return []hcov1beta1.DataImportCronTemplateStatus{
+			{
+				DataImportCronTemplate: hcov1beta1.DataImportCronTemplate{
+					ObjectMeta: metav1.ObjectMeta{Name: dictName, Namespace: namespace},
+					Spec: &cdiv1beta1.DataImportCronSpec{
+						ManagedDataSource: dsName,
+					},
+				},
+				Status: hcov1beta1.DataImportCronStatus{CommonTemplate: true},
+			},
+		}
+	}
+
+	newDataImportCron := func(namespace string, upToDate corev1.ConditionStatus) *cdiv1beta1.DataImportCron {
+		return &cdiv1beta1.DataImportCron{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dictName,
+				Namespace: namespace,
+				Annotations: map[string]string{
+					dataImportCronDesiredDigestAnnKey: digest,
+				},
+			},
+			Status: cdiv1beta1.DataImportCronStatus{
+				LastImportTimestamp: &lastImport,
+				Conditions: []cdiv1beta1.DataImportCronCondition{
+					{
+						Type: cdiv1beta1.DataImportCronUpToDate,
+						ConditionState: cdiv1beta1.ConditionState{
+							Status:             upToDate,
+							LastTransitionTime: transitionTime,
+							Reason:             "UpToDate",
+						},
+					},
+					{
+						Type: cdiv1beta1.DataImportCronProgressing,
+						ConditionState: cdiv1beta1.ConditionState{
+							Status:             corev1.ConditionFalse,
+							LastTransitionTime: transitionTime,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newDataSource := func(namespace string) *cdiv1beta1.DataSource {
+		return &cdiv1beta1.DataSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dsName,
+				Namespace: namespace,
+			},
+			Status: cdiv1beta1.DataSourceStatus{
+				Conditions: []cdiv1beta1.DataSourceCondition{
+					{
+						Type: cdiv1beta1.DataSourceReady,
+						ConditionState: cdiv1beta1.ConditionState{
+							Status:             corev1.ConditionTrue,
+							LastTransitionTime: transitionTime,
+							Reason:             "Ready",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("should fold the DataImportCron and the DataSource state into the status", func() {
+		cl := commontestutils.InitClient([]client.Object{
+			newDataImportCron(defaultGoldenImagesNamespace, corev1.ConditionTrue),
+			newDataSource(defaultGoldenImagesNamespace),
+		})
+
+		dicts := newDictStatus("")
+		Expect(SetDataImportCronTemplatesImportStatus(context.Background(), cl, dicts, nil)).To(Succeed())
+
+		status := dicts[0].Status
+		Expect(status.LastImportTimestamp).To(HaveValue(Equal(lastImport)))
+		Expect(status.CurrentDigest).To(Equal(digest))
+
+		upToDate := meta.FindStatusCondition(status.Conditions, DictConditionUpToDateType)
+		Expect(upToDate).ToNot(BeNil())
+		Expect(upToDate.Status).To(Equal(metav1.ConditionTrue))
+		Expect(upToDate.Reason).To(Equal("UpToDate"))
+		Expect(upToDate.LastTransitionTime).To(Equal(transitionTime))
+
+		progressing := meta.FindStatusCondition(status.Conditions, DictConditionProgressingType)
+		Expect(progressing).ToNot(BeNil())
+		Expect(progressing.Status).To(Equal(metav1.ConditionFalse))
+		Expect(progressing.Reason).To(Equal(dictConditionDefaultReason))
+
+		Expect(meta.IsStatusConditionTrue(status.Conditions, DictConditionDataSourceReadyType)).To(BeTrue())
+	})
+
+	It("should use the DICT namespace, if set", func() {
+		cl := commontestutils.InitClient([]client.Object{
+			newDataImportCron("custom-ns", corev1.ConditionFalse),
+		})
+
+		dicts := newDictStatus("custom-ns")
+		Expect(SetDataImportCronTemplatesImportStatus(context.Background(), cl, dicts, nil)).To(Succeed())
+
+		Expect(meta.IsStatusConditionFalse(dicts[0].Status.Conditions, DictConditionUpToDateType)).To(BeTrue())
+		Expect(meta.FindStatusCondition(dicts[0].Status.Conditions, DictConditionDataSourceReadyType)).To(BeNil())
+	})
+
+	It("should not report the import status if the DataImportCron was not created yet", func() {
+		cl := commontestutils.InitClient([]client.Object{})
+
+		dicts := newDictStatus("")
+		Expect(SetDataImportCronTemplatesImportStatus(context.Background(), cl, dicts, nil)).To(Succeed())
+
+		Expect(dicts[0].Status.LastImportTimestamp).To(BeNil())
+		Expect(dicts[0].Status.CurrentDigest).To(BeEmpty())
+		Expect(dicts[0].Status.Conditions).To(BeEmpty())
+	})
+
+	It("should not report the import status if the DICT is not deployed", func() {
+		cl := commontestutils.InitClient([]client.Object{
+			newDataImportCron(defaultGoldenImagesNamespace, corev1.ConditionTrue),
+		})
+
+		dicts := newDictStatus("")
+		meta.SetStatusCondition(&dicts[0].Status.Conditions, metav1.Condition{
+			Type:   DictConditionDeployedType,
+			Status: metav1.ConditionFalse,
+			Reason: dictConditionDeployedReason,
+		})
+		Expect(SetDataImportCronTemplatesImportStatus(context.Background(), cl, dicts, nil)).To(Succeed())
+
+		Expect(dicts[0].Status.LastImportTimestamp).To(BeNil())
+		Expect(dicts[0].Status.Conditions).To(HaveLen(1))
+	})
+
+	It("should keep the transition time of an unchanged condition", func() {
+		dic := newDataImportCron(defaultGoldenImagesNamespace, corev1.ConditionTrue)
+		dic.Status.Conditions[0].LastTransitionTime = metav1.Time{}
+		cl := commontestutils.InitClient([]client.Object{dic})
+
+		prevTransitionTime := metav1.NewTime(time.Now().Add(-24 * time.Hour).Truncate(time.Second))
+		prev := newDictStatus("")
+		prev[0].Status.Conditions = []metav1.Condition{
+			{
+				Type:               DictConditionUpToDateType,
+				Status:             metav1.ConditionTrue,
+				Reason:             "UpToDate",
+				LastTransitionTime: prevTransitionTime,
+			},
+		}
+
+		dicts := newDictStatus("")
+		Expect(SetDataImportCronTemplatesImportStatus(context.Background(), cl, dicts, prev)).To(Succeed())
+
+		upToDate := meta.FindStatusCondition(dicts[0].Status.Conditions, DictConditionUpToDateType)
+		Expect(upToDate).ToNot(BeNil())
+		Expect(upToDate.LastTransitionTime).To(Equal(prevTransitionTime))
+	})
+
+	It("should set the golden image freshness metrics", func() {
+		cl := commontestutils.InitClient([]client.Object{
+			newDataImportCron(defaultGoldenImagesNamespace, corev1.ConditionFalse),
+		})
+
+		dicts := newDictStatus("")
+		Expect(SetDataImportCronTemplatesImportStatus(context.Background(), cl, dicts, nil)).To(Succeed())
+
+		SetDataImportCronTemplatesImportMetrics(dicts)
+
+		Expect(metrics.GetDICTLastImportTimestamp(dictName, dsName)).To(BeEquivalentTo(lastImport.Unix()))
+		Expect(metrics.IsDICTUpToDate(dictName, dsName)).To(BeFalse())
+	})
+})
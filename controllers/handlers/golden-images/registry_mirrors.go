@@ -0,0 +1,74 @@
+package golden_images
+
+import (
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const dockerURLScheme = "docker://"
+
+func getRegistryMirrors(hc *hcov1beta1.HyperConverged) []hcov1beta1.RegistryMirror {
+	if hc.Spec.StorageImport == nil {
+		return nil
+	}
+
+	return hc.Spec.StorageImport.RegistryMirrors
+}
+
+// applyRegistryMirrors rewrites the registry source URL of the DataImportCronTemplate, if it matches one of the
+// registry mirrors.
+func applyRegistryMirrors(dict *hcov1beta1.DataImportCronTemplateStatus, mirrors []hcov1beta1.RegistryMirror) {
+	if len(mirrors) == 0 || dict.Spec == nil {
+		return
+	}
+
+	source := dict.Spec.Template.Spec.Source
+	if source == nil || source.Registry == nil || source.Registry.URL == nil {
+		return
+	}
+
+	if url, rewritten := rewriteRegistryURL(*source.Registry.URL, mirrors); rewritten {
+		source.Registry.URL = ptr.To(url)
+	}
+}
+
+// rewriteRegistryURL replaces the longest matching source of the registry mirrors, with its mirror. A source
+// matches if the image reference is equal to it, or starts with it, followed by "/", ":" or "@".
+func rewriteRegistryURL(url string, mirrors []hcov1beta1.RegistryMirror) (string, bool) {
+	image, found := strings.CutPrefix(url, dockerURLScheme)
+	if !found {
+		return url, false
+	}
+
+	var matched *hcov1beta1.RegistryMirror
+	for i, mirror := range mirrors {
+		if !matchRegistrySource(image, mirror.Source) {
+			continue
+		}
+
+		if matched == nil || len(mirror.Source) > len(matched.Source) {
+			matched = &mirrors[i]
+		}
+	}
+
+	if matched == nil {
+		return url, false
+	}
+
+	source := strings.TrimSuffix(matched.Source, "/")
+	mirror := strings.TrimSuffix(matched.Mirror, "/")
+
+	return dockerURLScheme + mirror + strings.TrimPrefix(image, source), true
+}
+
+func matchRegistrySource(image, source string) bool {
+	rest, found := strings.CutPrefix(image, strings.TrimSuffix(source, "/"))
+	if !found {
+		return false
+	}
+
+	return rest == "" || strings.ContainsAny(rest[:1], "/:@")
+}
@@ -0,0 +1,74 @@
+package golden_images
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Test the registry mirrors of the golden images", func() {
+	mirrors := []hcov1beta1.RegistryMirror{
+		{Source: "quay.io", Mirror: "mirror.example.com:5000"},
+		{Source: "quay.io/containerdisks/", Mirror: "mirror.example.com:5000/disks/"},
+		{Source: "registry.example.com/images/fedora", Mirror: "local.example.com/fedora-mirror"},
+	}
+
+	DescribeTable("should rewrite the registry URL", func(url, expected string, expectRewritten bool) {
+		rewrittenURL, rewritten := rewriteRegistryURL(url, mirrors)
+		Expect(rewritten).To(Equal(expectRewritten))
+		Expect(rewrittenURL).To(Equal(expected))
+	},
+		Entry("longest matching source",
+			"docker://quay.io/containerdisks/fedora:latest", "docker://mirror.example.com:5000/disks/fedora:latest", true),
+		Entry("registry host source",
+			"docker://quay.io/other/image:1", "docker://mirror.example.com:5000/other/image:1", true),
+		Entry("full repository source, with a tag",
+			"docker://registry.example.com/images/fedora:42", "docker://local.example.com/fedora-mirror:42", true),
+		Entry("full repository source, with a digest",
+			"docker://registry.example.com/images/fedora@sha256:1234", "docker://local.example.com/fedora-mirror@sha256:1234", true),
+		Entry("partial path component should not match",
+			"docker://registry.example.com/images/fedora-coreos:42", "docker://registry.example.com/images/fedora-coreos:42", false),
+		Entry("partial host should not match",
+			"docker://quay.io.example.com/image:1", "docker://quay.io.example.com/image:1", false),
+		Entry("not a docker URL",
+			"oci-archive://quay.io/image", "oci-archive://quay.io/image", false),
+	)
+
+	It("should rewrite the common DataImportCronTemplates, without marking them as modified", func() {
+		origHardCodedMap := dataImportCronTemplateHardCodedMap
+		DeferCleanup(func() {
+			dataImportCronTemplateHardCodedMap = origHardCodedMap
+		})
+
+		image1, _ := makeDICT(1, true)
+		image1.Spec.Template.Spec.Source.Registry.URL = ptr.To("docker://quay.io/containerdisks/image1")
+		dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{image1.Name: image1}
+
+		image2, _ := makeDICT(2, false)
+		image2.Spec.Template.Spec.Source.Registry.URL = ptr.To("docker://quay.io/containerdisks/image2")
+
+		hco := commontestutils.NewHco()
+		hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image2}
+		hco.Spec.StorageImport = &hcov1beta1.StorageImportConfig{
+			RegistryMirrors: mirrors,
+		}
+
+		dicts, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dicts).To(HaveLen(2))
+
+		Expect(dicts[0].Name).To(Equal(image1.Name))
+		Expect(dicts[0].Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal("docker://mirror.example.com:5000/disks/image1")))
+		Expect(dicts[0].Status.Modified).To(BeFalse())
+
+		By("should not rewrite custom DataImportCronTemplates")
+		Expect(dicts[1].Name).To(Equal(image2.Name))
+		Expect(dicts[1].Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal("docker://quay.io/containerdisks/image2")))
+
+		By("should not modify the hard-coded DataImportCronTemplate")
+		Expect(dataImportCronTemplateHardCodedMap[image1.Name].Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal("docker://quay.io/containerdisks/image1")))
+	})
+})
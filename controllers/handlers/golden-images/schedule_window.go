@@ -0,0 +1,43 @@
+package golden_images
+
+import (
+	"fmt"
+	"time"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const (
+	minutesInHour = 60
+	minutesInDay  = 24 * minutesInHour
+)
+
+// getWindowSchedules spreads the import schedules of the common DataImportCronTemplates evenly within the import
+// window. The dictNames must be sorted, so the schedules are stable as long as the window and the list of the common
+// DataImportCronTemplates are not changed. Returns nil if the window is not set, or if it is not valid.
+func getWindowSchedules(window *hcov1beta1.DataImportScheduleWindow, dictNames []string) map[string]string {
+	if window == nil || len(dictNames) == 0 {
+		return nil
+	}
+
+	start, err := time.Parse("15:04", window.Start)
+	if err != nil {
+		logger.Error(err, "invalid dataImportScheduleWindow start time; ignoring the window", "start", window.Start)
+		return nil
+	}
+
+	durationMinutes := int(window.Duration.Minutes())
+	if durationMinutes < 1 || durationMinutes > minutesInDay {
+		logger.Info("invalid dataImportScheduleWindow duration; ignoring the window", "duration", window.Duration.String())
+		return nil
+	}
+
+	startMinute := start.Hour()*minutesInHour + start.Minute()
+	schedules := make(map[string]string, len(dictNames))
+	for i, name := range dictNames {
+		minute := (startMinute + i*durationMinutes/len(dictNames)) % minutesInDay
+		schedules[name] = fmt.Sprintf("%d %d * * *", minute%minutesInHour, minute/minutesInHour)
+	}
+
+	return schedules
+}
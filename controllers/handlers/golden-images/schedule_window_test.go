@@ -0,0 +1,104 @@
+package golden_images
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Test the golden images schedule window", func() {
+	newWindow := func(start string, duration time.Duration) *hcov1beta1.DataImportScheduleWindow {
+		return &hcov1beta1.DataImportScheduleWindow{
+			Start:    start,
+			Duration: metav1.Duration{Duration: duration},
+		}
+	}
+
+	It("should return nil if the window is not set", func() {
+		Expect(getWindowSchedules(nil, []string{"a", "b"})).To(BeNil())
+	})
+
+	It("should spread the schedules within the window", func() {
+		schedules := getWindowSchedules(newWindow("01:30", 2*time.Hour), []string{"a", "b", "c", "d"})
+		Expect(schedules).To(Equal(map[string]string{
+			"a": "30 1 * * *",
+			"b": "0 2 * * *",
+			"c": "30 2 * * *",
+			"d": "0 3 * * *",
+		}))
+	})
+
+	It("should wrap the schedules around midnight", func() {
+		schedules := getWindowSchedules(newWindow("23:00", 2*time.Hour), []string{"a", "b", "c"})
+		Expect(schedules).To(Equal(map[string]string{
+			"a": "0 23 * * *",
+			"b": "40 23 * * *",
+			"c": "20 0 * * *",
+		}))
+	})
+
+	It("should ignore an invalid window", func() {
+		Expect(getWindowSchedules(newWindow("25:00", time.Hour), []string{"a"})).To(BeNil())
+		Expect(getWindowSchedules(newWindow("01:00", 30*time.Second), []string{"a"})).To(BeNil())
+		Expect(getWindowSchedules(newWindow("01:00", 25*time.Hour), []string{"a"})).To(BeNil())
+	})
+
+	Context("GetDataImportCronTemplates", func() {
+		BeforeEach(func() {
+			origHardCodedMap := dataImportCronTemplateHardCodedMap
+			DeferCleanup(func() {
+				dataImportCronTemplateHardCodedMap = origHardCodedMap
+			})
+
+			image1, _ := makeDICT(1, true)
+			image2, _ := makeDICT(2, true)
+			dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{
+				image1.Name: image1,
+				image2.Name: image2,
+			}
+		})
+
+		It("should use the window schedules for the common templates", func() {
+			customImage, _ := makeDICT(3, false)
+			modifiedImage, _ := makeDICT(2, true)
+			modifiedImage.Spec.Schedule = ""
+			modifiedImage.Spec.ManagedDataSource = "modified"
+
+			hco := commontestutils.NewHco()
+			hco.Spec.DataImportScheduleWindow = newWindow("02:00", time.Hour)
+			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{modifiedImage, customImage}
+
+			dicts, err := GetDataImportCronTemplates(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dicts).To(HaveLen(3))
+
+			Expect(dicts[0].Name).To(Equal("image1"))
+			Expect(dicts[0].Spec.Schedule).To(Equal("0 2 * * *"))
+
+			By("should use the window schedule for a modified common template without a schedule")
+			Expect(dicts[1].Name).To(Equal("image2"))
+			Expect(dicts[1].Status.Modified).To(BeTrue())
+			Expect(dicts[1].Spec.Schedule).To(Equal("30 2 * * *"))
+
+			By("should not change the schedule of a custom template")
+			Expect(dicts[2].Name).To(Equal("image3"))
+			Expect(dicts[2].Spec.Schedule).To(Equal(customImage.Spec.Schedule))
+
+			By("should regenerate the schedules when the window is changed")
+			hco.Spec.DataImportScheduleWindow = newWindow("20:00", 2*time.Hour)
+
+			dicts, err = GetDataImportCronTemplates(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dicts[0].Spec.Schedule).To(Equal("0 20 * * *"))
+			Expect(dicts[1].Spec.Schedule).To(Equal("0 21 * * *"))
+
+			By("should not modify the hard-coded templates")
+			Expect(dataImportCronTemplateHardCodedMap["image1"].Spec.Schedule).To(Equal("1 */12 * * *"))
+		})
+	})
+})
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/url"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// importProxyCAConfigMapName is the name of the ConfigMap with the trusted CA bundle of the cluster-wide proxy,
+	// in the format that CDI expects for the importProxy.trustedCAProxy ConfigMap
+	importProxyCAConfigMapName = "kubevirt-import-proxy-ca"
+	importProxyCAKey           = "ca.pem"
+
+	maskedProxyCredentials = "xxxxx"
+)
+
+// NewImportProxyCAConfigMapHandler copies the trusted CA bundle of the cluster-wide proxy to a ConfigMap in the HCO
+// namespace, for the CDI importer pods. The ConfigMap is removed when the cluster-wide proxy has no trusted CA, or
+// when it is overridden by spec.importProxy.
+func NewImportProxyCAConfigMapHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return operands.NewConditionalHandler(
+		operands.NewDynamicCmHandler(Client, Scheme, NewImportProxyCAConfigMap),
+		useClusterProxyTrustedCA,
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return NewImportProxyCAConfigMap(hc)
+		},
+	)
+}
+
+func NewImportProxyCAConfigMap(hc *hcov1beta1.HyperConverged) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      importProxyCAConfigMapName,
+			Labels:    operands.GetLabels(hc, hcoutil.AppComponentStorage),
+			Namespace: hc.Namespace,
+		},
+	}
+
+	if clusterProxy := hcoutil.GetClusterInfo().GetClusterProxy(); clusterProxy != nil {
+		cm.Data = map[string]string{
+			importProxyCAKey: clusterProxy.TrustedCA,
+		}
+	}
+
+	return cm
+}
+
+func useClusterProxyTrustedCA(hc *hcov1beta1.HyperConverged) bool {
+	if hc.Spec.ImportProxy != nil {
+		return false
+	}
+
+	clusterProxy := hcoutil.GetClusterInfo().GetClusterProxy()
+	return clusterProxy != nil && clusterProxy.TrustedCA != ""
+}
+
+// GetImportProxy returns the proxy configuration of the CDI importer pods: spec.importProxy if it is set, or else
+// the cluster-wide proxy, if any.
+func GetImportProxy(hc *hcov1beta1.HyperConverged) *cdiv1beta1.ImportProxy {
+	if hc.Spec.ImportProxy != nil {
+		return hc.Spec.ImportProxy.DeepCopy()
+	}
+
+	clusterProxy := hcoutil.GetClusterInfo().GetClusterProxy()
+	if clusterProxy == nil {
+		return nil
+	}
+
+	importProxy := &cdiv1beta1.ImportProxy{
+		HTTPProxy:  nonEmptyStringPtr(clusterProxy.HTTPProxy),
+		HTTPSProxy: nonEmptyStringPtr(clusterProxy.HTTPSProxy),
+		NoProxy:    nonEmptyStringPtr(clusterProxy.NoProxy),
+	}
+
+	if useClusterProxyTrustedCA(hc) {
+		importProxy.TrustedCAProxy = ptr.To(importProxyCAConfigMapName)
+	}
+
+	if *importProxy == (cdiv1beta1.ImportProxy{}) {
+		return nil
+	}
+
+	return importProxy
+}
+
+// GetImportProxyStatus returns the effective proxy configuration of the CDI importer pods, with the credentials in
+// the proxy URLs masked
+func GetImportProxyStatus(hc *hcov1beta1.HyperConverged) *cdiv1beta1.ImportProxy {
+	importProxy := GetImportProxy(hc)
+	if importProxy == nil {
+		return nil
+	}
+
+	if importProxy.HTTPProxy != nil {
+		importProxy.HTTPProxy = ptr.To(maskProxyCredentials(*importProxy.HTTPProxy))
+	}
+
+	if importProxy.HTTPSProxy != nil {
+		importProxy.HTTPSProxy = ptr.To(maskProxyCredentials(*importProxy.HTTPSProxy))
+	}
+
+	return importProxy
+}
+
+// maskProxyCredentials replaces the user name and the password in the proxy URL. A URL that can't be parsed is
+// masked completely, as it may still contain credentials.
+func maskProxyCredentials(proxyURL string) string {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return maskedProxyCredentials
+	}
+
+	if u.User == nil {
+		return proxyURL
+	}
+
+	if _, hasPassword := u.User.Password(); hasPassword {
+		u.User = url.UserPassword(maskedProxyCredentials, maskedProxyCredentials)
+	} else {
+		u.User = url.User(maskedProxyCredentials)
+	}
+
+	return u.String()
+}
+
+func nonEmptyStringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return ptr.To(s)
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/net"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkaddonsshared "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/shared"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	kubeSecondaryDNSServiceName = "secondary-dns-external"
+	// kubeSecondaryDNSPort is the port that the KubeSecondaryDNS pods, deployed by CNAO, listen on
+	kubeSecondaryDNSPort = 5353
+	dnsPort              = 53
+)
+
+// kubeSecondaryDNSPodLabels are the labels of the KubeSecondaryDNS pods, deployed by CNAO
+var kubeSecondaryDNSPodLabels = map[string]string{"k8s-app": "secondary-dns"}
+
+// IsKubeSecondaryDNSEnabled returns true if KubeSecondaryDNS should be deployed
+func IsKubeSecondaryDNSEnabled(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.KubeSecondaryDNS != nil && ptr.Deref(hc.Spec.KubeSecondaryDNS.Deploy, false)
+}
+
+// GetKubeSecondaryDNSNameServerIP returns the name server IP of KubeSecondaryDNS. The deprecated
+// spec.kubeSecondaryDNSNameServerIP field is used if spec.kubeSecondaryDNS.nameServerIP is not set.
+func GetKubeSecondaryDNSNameServerIP(hc *hcov1beta1.HyperConverged) (string, error) {
+	var nameServerIP string
+	if hc.Spec.KubeSecondaryDNS != nil && hc.Spec.KubeSecondaryDNS.NameServerIP != "" {
+		nameServerIP = hc.Spec.KubeSecondaryDNS.NameServerIP
+	} else if hc.Spec.KubeSecondaryDNSNameServerIP != nil {
+		nameServerIP = *hc.Spec.KubeSecondaryDNSNameServerIP
+	}
+
+	if nameServerIP != "" && !net.IsIPv4String(nameServerIP) {
+		return "", errors.New("kubeSecondaryDNS nameServerIP isn't a valid IPv4")
+	}
+
+	return nameServerIP, nil
+}
+
+func getKubeSecondaryDNSDomain(hc *hcov1beta1.HyperConverged) string {
+	if hc.Spec.KubeSecondaryDNS != nil && hc.Spec.KubeSecondaryDNS.Domain != "" {
+		return hc.Spec.KubeSecondaryDNS.Domain
+	}
+	return util.GetClusterInfo().GetBaseDomain()
+}
+
+func hcoKubeSecondaryDNS2CnaoKubeSecondaryDNS(hc *hcov1beta1.HyperConverged) (*networkaddonsshared.KubeSecondaryDNS, error) {
+	nameServerIP, err := GetKubeSecondaryDNSNameServerIP(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsKubeSecondaryDNSEnabled(hc) {
+		return nil, nil
+	}
+
+	return &networkaddonsshared.KubeSecondaryDNS{
+		Domain:       getKubeSecondaryDNSDomain(hc),
+		NameServerIP: nameServerIP,
+	}, nil
+}
+
+func shouldExposeKubeSecondaryDNS(hc *hcov1beta1.HyperConverged) bool {
+	return IsKubeSecondaryDNSEnabled(hc) && hc.Spec.KubeSecondaryDNS.Exposure != nil
+}
+
+// NewKubeSecondaryDNSServiceHandler returns the handler of the Service that exposes KubeSecondaryDNS outside the
+// cluster. The Service only exists if KubeSecondaryDNS is deployed and exposed.
+func NewKubeSecondaryDNSServiceHandler(cli client.Client, scheme *runtime.Scheme) operands.Operand {
+	return operands.NewConditionalHandler(
+		operands.NewServiceHandler(cli, scheme, NewKubeSecondaryDNSService),
+		shouldExposeKubeSecondaryDNS,
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return NewKubeSecondaryDNSService(hc)
+		},
+	)
+}
+
+// NewKubeSecondaryDNSService returns the Service that exposes KubeSecondaryDNS outside the cluster. DNS is served
+// over UDP, and over TCP for the responses that are too large for UDP, and for the zone transfers.
+func NewKubeSecondaryDNSService(hc *hcov1beta1.HyperConverged) *corev1.Service {
+	ports := []corev1.ServicePort{
+		{
+			Name:       "dns",
+			Protocol:   corev1.ProtocolUDP,
+			Port:       dnsPort,
+			TargetPort: intstr.FromInt32(kubeSecondaryDNSPort),
+		},
+		{
+			Name:       "dns-tcp",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       dnsPort,
+			TargetPort: intstr.FromInt32(kubeSecondaryDNSPort),
+		},
+	}
+
+	serviceType := corev1.ServiceTypeLoadBalancer
+	if hc.Spec.KubeSecondaryDNS != nil && hc.Spec.KubeSecondaryDNS.Exposure != nil {
+		serviceType = hc.Spec.KubeSecondaryDNS.Exposure.ServiceType
+		if serviceType == corev1.ServiceTypeNodePort {
+			// the node ports are unique per protocol, so both ports can use the same node port
+			for i := range ports {
+				ports[i].NodePort = hc.Spec.KubeSecondaryDNS.Exposure.NodePort
+			}
+		}
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kubeSecondaryDNSServiceName,
+			Namespace: hc.Namespace,
+			Labels:    operands.GetLabels(hc, util.AppComponentNetwork),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     serviceType,
+			Selector: kubeSecondaryDNSPodLabels,
+			Ports:    ports,
+		},
+	}
+}
+
+// GetKubeSecondaryDNSStatus returns the domain that KubeSecondaryDNS serves, and its external address, if it is
+// exposed. It returns nil if KubeSecondaryDNS is not deployed.
+func GetKubeSecondaryDNSStatus(ctx context.Context, cli client.Reader, hc *hcov1beta1.HyperConverged) *hcov1beta1.KubeSecondaryDNSStatus {
+	if !IsKubeSecondaryDNSEnabled(hc) {
+		return nil
+	}
+
+	status := &hcov1beta1.KubeSecondaryDNSStatus{
+		Domain: getKubeSecondaryDNSDomain(hc),
+	}
+
+	if !shouldExposeKubeSecondaryDNS(hc) {
+		return status
+	}
+
+	svc := NewKubeSecondaryDNSService(hc)
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(svc), svc); err != nil || len(svc.Spec.Ports) == 0 {
+		return status
+	}
+
+	switch svc.Spec.Type {
+	case corev1.ServiceTypeLoadBalancer:
+		for _, ingress := range svc.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" {
+				status.ExternalAddress = ingress.IP
+			} else {
+				status.ExternalAddress = ingress.Hostname
+			}
+
+			if status.ExternalAddress != "" {
+				status.ExternalPort = svc.Spec.Ports[0].Port
+				break
+			}
+		}
+	case corev1.ServiceTypeNodePort:
+		status.ExternalPort = svc.Spec.Ports[0].NodePort
+	}
+
+	return status
+}
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("KubeSecondaryDNS", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	Context("NetworkAddonsConfig", func() {
+		It("should not deploy KubeSecondaryDNS by default", func() {
+			cna, err := NewNetworkAddons(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cna.Spec.KubeSecondaryDNS).To(BeNil())
+		})
+
+		It("should ignore the deprecated feature gate", func() {
+			hco.Spec.FeatureGates.DeployKubeSecondaryDNS = ptr.To(true) //nolint:staticcheck
+
+			cna, err := NewNetworkAddons(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cna.Spec.KubeSecondaryDNS).To(BeNil())
+		})
+
+		It("should use the configured domain and name server IP", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy:       ptr.To(true),
+				Domain:       "vms.example.com",
+				NameServerIP: "192.0.2.10",
+			}
+			hco.Spec.KubeSecondaryDNSNameServerIP = ptr.To("192.0.2.20")
+
+			cna, err := NewNetworkAddons(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cna.Spec.KubeSecondaryDNS).ToNot(BeNil())
+			Expect(cna.Spec.KubeSecondaryDNS.Domain).To(Equal("vms.example.com"))
+			Expect(cna.Spec.KubeSecondaryDNS.NameServerIP).To(Equal("192.0.2.10"))
+		})
+
+		It("should fall back to the deprecated name server IP field", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{Deploy: ptr.To(true)}
+			hco.Spec.KubeSecondaryDNSNameServerIP = ptr.To("192.0.2.20")
+
+			cna, err := NewNetworkAddons(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cna.Spec.KubeSecondaryDNS.NameServerIP).To(Equal("192.0.2.20"))
+		})
+
+		It("should fail if the name server IP is not an IPv4 address", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy:       ptr.To(true),
+				NameServerIP: "2001:db8::10",
+			}
+
+			_, err := NewNetworkAddons(hco)
+			Expect(err).To(MatchError(ContainSubstring("isn't a valid IPv4")))
+		})
+	})
+
+	Context("Service", func() {
+		getService := func(cl client.Client) (*corev1.Service, error) {
+			svc := NewKubeSecondaryDNSService(hco)
+			err := cl.Get(context.TODO(), client.ObjectKeyFromObject(svc), svc)
+			return svc, err
+		}
+
+		It("should not create the Service if KubeSecondaryDNS is not exposed", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{Deploy: ptr.To(true)}
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			res := NewKubeSecondaryDNSServiceHandler(cl, commontestutils.GetScheme()).Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+
+			_, err := getService(cl)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should create a LoadBalancer Service", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy:   ptr.To(true),
+				Exposure: &hcov1beta1.KubeSecondaryDNSExposure{ServiceType: corev1.ServiceTypeLoadBalancer},
+			}
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			res := NewKubeSecondaryDNSServiceHandler(cl, commontestutils.GetScheme()).Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			svc, err := getService(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(svc.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+			Expect(svc.Spec.Selector).To(Equal(map[string]string{"k8s-app": "secondary-dns"}))
+			Expect(svc.Spec.Ports).To(HaveLen(2))
+			Expect(svc.Spec.Ports[0].Port).To(Equal(int32(53)))
+			Expect(svc.Spec.Ports[0].TargetPort.IntValue()).To(Equal(5353))
+			Expect(svc.Spec.Ports[0].Protocol).To(Equal(corev1.ProtocolUDP))
+			Expect(svc.Spec.Ports[1].Port).To(Equal(int32(53)))
+			Expect(svc.Spec.Ports[1].TargetPort.IntValue()).To(Equal(5353))
+			Expect(svc.Spec.Ports[1].Protocol).To(Equal(corev1.ProtocolTCP))
+		})
+
+		It("should update the Service type, and remove the Service when KubeSecondaryDNS is not exposed anymore", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy:   ptr.To(true),
+				Exposure: &hcov1beta1.KubeSecondaryDNSExposure{ServiceType: corev1.ServiceTypeLoadBalancer},
+			}
+			cl := commontestutils.InitClient([]client.Object{hco, NewKubeSecondaryDNSService(hco)})
+			handler := NewKubeSecondaryDNSServiceHandler(cl, commontestutils.GetScheme())
+
+			hco.Spec.KubeSecondaryDNS.Exposure = &hcov1beta1.KubeSecondaryDNSExposure{
+				ServiceType: corev1.ServiceTypeNodePort,
+				NodePort:    30053,
+			}
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			svc, err := getService(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(svc.Spec.Type).To(Equal(corev1.ServiceTypeNodePort))
+			Expect(svc.Spec.Ports[0].NodePort).To(Equal(int32(30053)))
+			Expect(svc.Spec.Ports[1].NodePort).To(Equal(int32(30053)))
+
+			hco.Spec.KubeSecondaryDNS.Deploy = ptr.To(false)
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+
+			_, err = getService(cl)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not update the Service because of the node port that the cluster allocated", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy:   ptr.To(true),
+				Exposure: &hcov1beta1.KubeSecondaryDNSExposure{ServiceType: corev1.ServiceTypeNodePort},
+			}
+			existing := NewKubeSecondaryDNSService(hco)
+			existing.Spec.Ports[0].NodePort = 31053
+			existing.Spec.Ports[1].NodePort = 32053
+			cl := commontestutils.InitClient([]client.Object{hco, existing})
+
+			res := NewKubeSecondaryDNSServiceHandler(cl, commontestutils.GetScheme()).Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+
+			svc, err := getService(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(svc.Spec.Ports[0].NodePort).To(Equal(int32(31053)))
+			Expect(svc.Spec.Ports[1].NodePort).To(Equal(int32(32053)))
+		})
+	})
+
+	Context("GetKubeSecondaryDNSStatus", func() {
+		It("should not report a status if KubeSecondaryDNS is not deployed", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			Expect(GetKubeSecondaryDNSStatus(context.TODO(), cl, hco)).To(BeNil())
+		})
+
+		It("should only report the domain if KubeSecondaryDNS is not exposed", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy: ptr.To(true),
+				Domain: "vms.example.com",
+			}
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			Expect(GetKubeSecondaryDNSStatus(context.TODO(), cl, hco)).To(Equal(&hcov1beta1.KubeSecondaryDNSStatus{
+				Domain: "vms.example.com",
+			}))
+		})
+
+		It("should report the load balancer address", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy:   ptr.To(true),
+				Domain:   "vms.example.com",
+				Exposure: &hcov1beta1.KubeSecondaryDNSExposure{ServiceType: corev1.ServiceTypeLoadBalancer},
+			}
+			svc := NewKubeSecondaryDNSService(hco)
+			svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "192.0.2.10"}}
+			cl := commontestutils.InitClient([]client.Object{hco, svc})
+
+			Expect(GetKubeSecondaryDNSStatus(context.TODO(), cl, hco)).To(Equal(&hcov1beta1.KubeSecondaryDNSStatus{
+				Domain:          "vms.example.com",
+				ExternalAddress: "192.0.2.10",
+				ExternalPort:    53,
+			}))
+		})
+
+		It("should not report an address before the load balancer is provisioned", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy:   ptr.To(true),
+				Domain:   "vms.example.com",
+				Exposure: &hcov1beta1.KubeSecondaryDNSExposure{ServiceType: corev1.ServiceTypeLoadBalancer},
+			}
+			cl := commontestutils.InitClient([]client.Object{hco, NewKubeSecondaryDNSService(hco)})
+
+			Expect(GetKubeSecondaryDNSStatus(context.TODO(), cl, hco)).To(Equal(&hcov1beta1.KubeSecondaryDNSStatus{
+				Domain: "vms.example.com",
+			}))
+		})
+
+		It("should report the node port", func() {
+			hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+				Deploy:   ptr.To(true),
+				Domain:   "vms.example.com",
+				Exposure: &hcov1beta1.KubeSecondaryDNSExposure{ServiceType: corev1.ServiceTypeNodePort},
+			}
+			svc := NewKubeSecondaryDNSService(hco)
+			svc.Spec.Ports[0].NodePort = 31053
+			cl := commontestutils.InitClient([]client.Object{hco, svc})
+
+			Expect(GetKubeSecondaryDNSStatus(context.TODO(), cl, hco)).To(Equal(&hcov1beta1.KubeSecondaryDNSStatus{
+				Domain:       "vms.example.com",
+				ExternalPort: 31053,
+			}))
+		})
+	})
+})
@@ -29,8 +29,10 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/networkbinding"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/passt"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/patch"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/reformatobj"
@@ -74,38 +76,9 @@ func init() {
 		useKVMEmulation = err == nil && isKVMEmulation
 	}
 
-	mandatoryKvFeatureGates = getMandatoryKvFeatureGates(useKVMEmulation)
+	mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(useKVMEmulation)
 }
 
-// KubeVirt hard coded FeatureGates
-// These feature gates are set by HCO in the KubeVirt CR and can't be modified by the end user.
-const (
-	// Enables the CPUManager feature gate to label the nodes which have the Kubernetes CPUManager running. VMIs that
-	// require dedicated CPU resources will automatically be scheduled on the labeled nodes
-	kvCPUManagerGate = "CPUManager"
-
-	// Enables the alpha offline snapshot functionality
-	kvSnapshotGate = "Snapshot"
-
-	// Allow attaching a data volume to a running VMI
-	kvHotplugVolumesGate = "HotplugVolumes"
-
-	// Allow attaching a data volume to a running VMI using declarative API
-	kvDeclarativeHotplugVolumesGate = "DeclarativeHotplugVolumes"
-
-	// Allow assigning host devices to virtual machines
-	kvHostDevicesGate = "HostDevices"
-
-	// Expand disks to the largest size
-	kvExpandDisksGate = "ExpandDisks"
-
-	// Export VMs to outside of the cluster
-	kvVMExportGate = "VMExport"
-
-	// Enable the installation of the KubeVirt seccomp profile
-	kvKubevirtSeccompProfile = "KubevirtSeccompProfile"
-)
-
 // KubeVirt architecture dependant feature gates.
 // These feature gates are set by HCO in the KubeVirt CR and can't be modified by the end user.
 const (
@@ -119,47 +92,26 @@ const (
 )
 
 var (
-	hardCodeKvFgs = []string{
-		kvCPUManagerGate,
-		kvSnapshotGate,
-		kvExpandDisksGate,
-		kvHostDevicesGate,
-		kvVMExportGate,
-		kvKubevirtSeccompProfile,
-	}
-
-	// holds a list of mandatory KubeVirt feature gates. Some of them are the hard coded feature gates and some of
-	// them are added according to conditions; e.g. if SSP is deployed.
+	// holds a list of mandatory KubeVirt feature gates, according to the KVM emulation mode. See the featuregates
+	// package for the list.
 	mandatoryKvFeatureGates []string
 )
 
-// These KubeVirt feature gates are automatically enabled in KubeVirt if SSP is deployed
+// KubeVirt feature gates that are exposed in HCO API. See the featuregates package for the mapping between the HCO
+// feature gates and the KubeVirt feature gates.
 const (
-	// Support migration for VMs with host-model CPU mode
-	kvWithHostModelCPU = "WithHostModelCPU"
-
-	// Enable HyperV strict host checking for HyperV enlightenments
-	kvHypervStrictCheck = "HypervStrictCheck"
-)
-
-var (
-	sspConditionKvFgs = []string{
-		kvWithHostModelCPU,
-		kvHypervStrictCheck,
-	}
-)
-
-// KubeVirt feature gates that are exposed in HCO API
-const (
-	kvDownwardMetrics            = "DownwardMetrics"
-	kvDisableMDevConfig          = "DisableMDEVConfiguration"
-	kvPersistentReservation      = "PersistentReservation"
-	kvAlignCPUs                  = "AlignCPUs"
-	kvPasstIPStackMigration      = "PasstIPStackMigration"
-	kvDecentralizedLiveMigration = "DecentralizedLiveMigration"
-	kvMultiArchitecture          = "MultiArchitecture"
-	kvVideoConfig                = "VideoConfig"
-	kvObjectGraph                = "ObjectGraph"
+	kvDownwardMetrics               = featuregates.KvDownwardMetrics
+	kvDisableMDevConfig             = featuregates.KvDisableMDevConfig
+	kvPersistentReservation         = featuregates.KvPersistentReservation
+	kvAlignCPUs                     = featuregates.KvAlignCPUs
+	kvDecentralizedLiveMigration    = featuregates.KvDecentralizedLiveMigration
+	kvMultiArchitecture             = featuregates.KvMultiArchitecture
+	kvVideoConfig                   = featuregates.KvVideoConfig
+	kvObjectGraph                   = featuregates.KvObjectGraph
+	kvHotplugVolumesGate            = featuregates.KvHotplugVolumesGate
+	kvDeclarativeHotplugVolumesGate = featuregates.KvDeclarativeHotplugVolumesGate
+
+	kvPasstIPStackMigration = passt.IPStackMigrationFeatureGate
 )
 
 // CPU Plugin default values
@@ -305,6 +257,7 @@ func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtcorev1
 		ProductVersion:              os.Getenv(hcoutil.HcoKvIoVersionName),
 		ProductComponent:            string(hcoutil.AppComponentCompute),
 		ServiceMonitorNamespace:     operands.GetNamespace(hc.Namespace, opts),
+		ImagePullSecrets:            operands.GetImagePullSecrets(hc),
 	}
 
 	kv := NewKubeVirtWithNameOnly(hc, opts...)
@@ -434,7 +387,7 @@ func getKVConfig(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.KubeVirtConfigu
 
 	seccompConfig := getKVSeccompConfig()
 
-	networkBindings := getNetworkBindings(hc.Spec.NetworkBinding, hc.Annotations)
+	networkBindings := getNetworkBindings(hc.Spec.NetworkBinding, GetEnabledNetworkBindingPlugins(hc))
 
 	config := &kubevirtcorev1.KubeVirtConfiguration{
 		DeveloperConfiguration: devConfig,
@@ -456,7 +409,7 @@ func getKVConfig(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.KubeVirtConfigu
 		KSMConfiguration:             hc.Spec.KSMConfiguration,
 		VMRolloutStrategy:            ptr.To(kubevirtcorev1.VMRolloutStrategyLiveUpdate),
 		LiveUpdateConfiguration:      hc.Spec.LiveUpdateConfiguration,
-		ArchitectureConfiguration:    getArchConfiguration(),
+		ArchitectureConfiguration:    GetArchConfiguration(hc.Spec.ArchitectureConfiguration),
 	}
 
 	if smbiosConfig, ok := os.LookupEnv(smbiosEnvName); ok {
@@ -513,7 +466,9 @@ var (
 	architectureConfiguration *kubevirtcorev1.ArchConfiguration
 )
 
-func getArchConfiguration() *kubevirtcorev1.ArchConfiguration {
+// getDefaultArchConfiguration returns the architecture configuration that is set by the environment variables of the
+// operator deployment
+func getDefaultArchConfiguration() *kubevirtcorev1.ArchConfiguration {
 	archConfigOnce.Do(func() {
 		amd64Comfig := getAMD64ArchConfig()
 		arm64Config := getARM64ArchConfig()
@@ -532,6 +487,56 @@ func getArchConfiguration() *kubevirtcorev1.ArchConfiguration {
 	return architectureConfiguration.DeepCopy()
 }
 
+// GetArchConfiguration returns the KubeVirt architecture configuration. The fields that are set in the HyperConverged
+// CR, override the default configuration of each architecture.
+func GetArchConfiguration(hcArchConfig *hcov1beta1.ArchitectureConfiguration) *kubevirtcorev1.ArchConfiguration {
+	archConfig := getDefaultArchConfiguration()
+	if hcArchConfig == nil {
+		return archConfig
+	}
+
+	if archConfig == nil {
+		archConfig = &kubevirtcorev1.ArchConfiguration{}
+	}
+
+	archConfig.Amd64 = mergeArchConfig(archConfig.Amd64, hcArchConfig.Amd64, DefaultAMD64OVMFPath, DefaultAMD64EmulatedQ35Machine, DefaultAMD64EmulatedPCQ35Machine)
+	archConfig.Arm64 = mergeArchConfig(archConfig.Arm64, hcArchConfig.Arm64, DefaultARM64OVMFPath, DefaultARM64EmulatedMachines)
+	archConfig.S390x = mergeArchConfig(archConfig.S390x, hcArchConfig.S390x, DefaultS390xOVMFPath, DefaultS390XEmulatedMachines)
+
+	if archConfig.Amd64 == nil && archConfig.Arm64 == nil && archConfig.S390x == nil {
+		return nil
+	}
+
+	return archConfig
+}
+
+func mergeArchConfig(archConfig *kubevirtcorev1.ArchSpecificConfiguration, hcArchConfig *hcov1beta1.ArchSpecificConfiguration, defaultOVMFPath string, defaultEmulatedMachines ...string) *kubevirtcorev1.ArchSpecificConfiguration {
+	if hcArchConfig == nil {
+		return archConfig
+	}
+
+	if archConfig == nil {
+		archConfig = &kubevirtcorev1.ArchSpecificConfiguration{
+			OVMFPath:         defaultOVMFPath,
+			EmulatedMachines: defaultEmulatedMachines,
+		}
+	}
+
+	if hcArchConfig.MachineType != "" {
+		archConfig.MachineType = hcArchConfig.MachineType
+	}
+
+	if hcArchConfig.OVMFPath != "" {
+		archConfig.OVMFPath = hcArchConfig.OVMFPath
+	}
+
+	if len(hcArchConfig.EmulatedMachines) > 0 {
+		archConfig.EmulatedMachines = slices.Clone(hcArchConfig.EmulatedMachines)
+	}
+
+	return archConfig
+}
+
 func getAMD64ArchConfig() *kubevirtcorev1.ArchSpecificConfiguration {
 	amd64MachineType := cmp.Or(
 		strings.TrimSpace(os.Getenv(machineTypeEnvName)),
@@ -579,7 +584,7 @@ func getS390xArchConfig() *kubevirtcorev1.ArchSpecificConfiguration {
 }
 
 func getNetworkBindings(hcoNetworkBindings map[string]kubevirtcorev1.InterfaceBindingPlugin,
-	hcoAnnotations map[string]string) map[string]kubevirtcorev1.InterfaceBindingPlugin {
+	bindingPlugins []*networkbinding.Plugin) map[string]kubevirtcorev1.InterfaceBindingPlugin {
 	networkBindings := maps.Clone(hcoNetworkBindings)
 
 	if networkBindings == nil {
@@ -588,8 +593,8 @@ func getNetworkBindings(hcoNetworkBindings map[string]kubevirtcorev1.InterfaceBi
 
 	networkBindings[primaryUDNNetworkBindingName] = primaryUserDefinedNetworkBinding()
 
-	if hcoAnnotations[passt.DeployPasstNetworkBindingAnnotation] == "true" {
-		networkBindings[passt.BindingName] = passt.NetworkBinding()
+	for _, plugin := range bindingPlugins {
+		networkBindings[plugin.BindingName] = plugin.Binding()
 	}
 	return networkBindings
 }
@@ -765,26 +770,15 @@ func hcTLSProtocolVersionToKv(profileMinVersion openshiftconfigv1.TLSProtocolVer
 }
 
 func hcTLSSecurityProfileToKv(profile *openshiftconfigv1.TLSSecurityProfile) *kubevirtcorev1.TLSConfiguration {
-	var profileCiphers []string
-	var profileMinVersion openshiftconfigv1.TLSProtocolVersion
-
 	if profile == nil {
 		return nil
 	}
 
-	if profile.Custom != nil {
-		profileCiphers = profile.Custom.Ciphers
-		profileMinVersion = profile.Custom.MinTLSVersion
-	} else if profile.Modern != nil {
-		profileMinVersion = openshiftconfigv1.TLSProfiles[profile.Type].MinTLSVersion
-	} else {
-		profileCiphers = openshiftconfigv1.TLSProfiles[profile.Type].Ciphers
-		profileMinVersion = openshiftconfigv1.TLSProfiles[profile.Type].MinTLSVersion
-	}
+	profileSpec := hcoutil.GetTLSProfileSpec(profile)
 
 	return &kubevirtcorev1.TLSConfiguration{
-		MinTLSVersion: hcTLSProtocolVersionToKv(profileMinVersion),
-		Ciphers:       crypto.OpenSSLToIANACipherSuites(profileCiphers),
+		MinTLSVersion: hcTLSProtocolVersionToKv(profileSpec.MinTLSVersion),
+		Ciphers:       crypto.OpenSSLToIANACipherSuites(profileSpec.Ciphers),
 	}
 }
 
@@ -799,7 +793,7 @@ func getKVDevConfig(hc *hcov1beta1.HyperConverged) *kubevirtcorev1.DeveloperConf
 		devConf.MemoryOvercommit = hc.Spec.HigherWorkloadDensity.MemoryOvercommitPercentage
 	}
 
-	fgs := getKvFeatureGateList(&hc.Spec.FeatureGates, hc.Annotations)
+	fgs := getKvFeatureGateList(&hc.Spec.FeatureGates, GetEnabledNetworkBindingPlugins(hc))
 	if len(fgs) > 0 {
 		devConf.FeatureGates = fgs
 	}
@@ -890,47 +884,11 @@ func hcoConfig2KvConfig(
 	return kvConfig
 }
 
-func getFeatureGateChecks(featureGates *hcov1beta1.HyperConvergedFeatureGates, annotations map[string]string) []string {
-	fgs := make([]string, 0, 2)
+func getFeatureGateChecks(featureGates *hcov1beta1.HyperConvergedFeatureGates, bindingPlugins []*networkbinding.Plugin) []string {
+	fgs := featuregates.KubeVirtFeatureGates(featureGates)
 
-	if ptr.Deref(featureGates.DownwardMetrics, false) {
-		fgs = append(fgs, kvDownwardMetrics)
-	}
-	if ptr.Deref(featureGates.DisableMDevConfiguration, false) {
-		fgs = append(fgs, kvDisableMDevConfig)
-	}
-	if ptr.Deref(featureGates.PersistentReservation, false) {
-		fgs = append(fgs, kvPersistentReservation)
-	}
-	if ptr.Deref(featureGates.AlignCPUs, false) {
-		fgs = append(fgs, kvAlignCPUs)
-	}
-	if ptr.Deref(featureGates.VideoConfig, true) {
-		fgs = append(fgs, kvVideoConfig)
-	}
-
-	if ptr.Deref(featureGates.ObjectGraph, false) {
-		fgs = append(fgs, kvObjectGraph)
-	}
-
-	if ptr.Deref(featureGates.DecentralizedLiveMigration, false) {
-		fgs = append(fgs, kvDecentralizedLiveMigration)
-	}
-
-	if ptr.Deref(featureGates.EnableMultiArchBootImageImport, false) {
-		fgs = append(fgs, kvMultiArchitecture)
-	}
-
-	// Add the appropriate volume hotplug featuregate based on DeclarativeHotplugVolumes setting
-	if ptr.Deref(featureGates.DeclarativeHotplugVolumes, false) {
-		fgs = append(fgs, kvDeclarativeHotplugVolumesGate)
-	} else {
-		// Default behavior: use the original HotplugVolumes featuregate
-		fgs = append(fgs, kvHotplugVolumesGate)
-	}
-
-	if annotations[passt.DeployPasstNetworkBindingAnnotation] == "true" {
-		fgs = append(fgs, kvPasstIPStackMigration)
+	for _, plugin := range bindingPlugins {
+		fgs = append(fgs, plugin.KubeVirtFeatureGates...)
 	}
 
 	if slices.Contains(nodeinfo.GetWorkloadsArchitectures(), nodeinfo.S390X) {
@@ -1045,18 +1003,9 @@ func translateKubeVirtConds(orig []kubevirtcorev1.KubeVirtCondition) []metav1.Co
 	return translated
 }
 
-func getMandatoryKvFeatureGates(isKVMEmulation bool) []string {
-	mandatoryFeatureGates := hardCodeKvFgs
-	if !isKVMEmulation {
-		mandatoryFeatureGates = append(mandatoryFeatureGates, sspConditionKvFgs...)
-	}
-
-	return mandatoryFeatureGates
-}
-
 // get list of feature gates or KV FG list
-func getKvFeatureGateList(fgs *hcov1beta1.HyperConvergedFeatureGates, annotations map[string]string) []string {
-	checks := getFeatureGateChecks(fgs, annotations)
+func getKvFeatureGateList(fgs *hcov1beta1.HyperConvergedFeatureGates, bindingPlugins []*networkbinding.Plugin) []string {
+	checks := getFeatureGateChecks(fgs, bindingPlugins)
 	res := make([]string, 0, len(checks)+len(mandatoryKvFeatureGates))
 	res = append(res, mandatoryKvFeatureGates...)
 	res = append(res, checks...)
@@ -1,14 +1,18 @@
 package handlers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"maps"
 	"os"
 	"reflect"
 	"slices"
+	"strings"
 
 	log "github.com/go-logr/logr"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	consolev1 "github.com/openshift/api/console/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -103,7 +107,7 @@ func NewKvUIProxySA(hc *hcov1beta1.HyperConverged) *corev1.ServiceAccount {
 
 // **** nginx config map Handler ****
 func NewKvUINginxCMHandler(_ log.Logger, Client client.Client, Scheme *runtime.Scheme, hc *hcov1beta1.HyperConverged) (operands.Operand, error) {
-	return operands.NewCmHandler(Client, Scheme, NewKVUINginxCM(hc)), nil
+	return operands.NewDynamicCmHandler(Client, Scheme, NewKVUINginxCM), nil
 }
 
 // **** UI user settings config map Handler ****
@@ -152,6 +156,10 @@ func NewKvUIPluginDeployment(hc *hcov1beta1.HyperConverged) *appsv1.Deployment {
 
 	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, nginxVolume)
 
+	// nginx only reads its configuration on startup; restart the pods when it is modified
+	configHash := sha256.Sum256([]byte(getNginxConfig(hc)))
+	deployment.Spec.Template.Annotations[hcoutil.ConfigHashAnnotation] = hex.EncodeToString(configHash[:])
+
 	return deployment
 }
 
@@ -328,7 +336,7 @@ func NewKvUIProxySvc(hc *hcov1beta1.HyperConverged) *corev1.Service {
 	}
 }
 
-var nginxConfig = fmt.Sprintf(`error_log /dev/stdout info;
+const nginxConfigTemplate = `error_log /dev/stdout info;
 events {}
 http {
 	access_log         /dev/stdout;
@@ -341,7 +349,8 @@ http {
 			listen              [::]:%[1]d ssl;
 			ssl_certificate     /var/serving-cert/tls.crt;
 			ssl_certificate_key /var/serving-cert/tls.key;
-			root                /usr/share/nginx/html;
+			ssl_protocols       %[2]s;
+%[3]s			root                /usr/share/nginx/html;
 
 			# Prevent caching for plugin-manifest.json and plugin-entry.js
 			# to avoid "Unexpected end of JSON input" error
@@ -357,7 +366,37 @@ http {
 			}
         }
 	}
-`, hcoutil.UIPluginServerPort)
+`
+
+// nginxTLSProtocols maps the minimal TLS version to the nginx ssl_protocols
+var nginxTLSProtocols = map[openshiftconfigv1.TLSProtocolVersion]string{
+	openshiftconfigv1.VersionTLS10: "TLSv1 TLSv1.1 TLSv1.2 TLSv1.3",
+	openshiftconfigv1.VersionTLS11: "TLSv1.1 TLSv1.2 TLSv1.3",
+	openshiftconfigv1.VersionTLS12: "TLSv1.2 TLSv1.3",
+	openshiftconfigv1.VersionTLS13: "TLSv1.3",
+}
+
+func getNginxConfig(hc *hcov1beta1.HyperConverged) string {
+	profileSpec := hcoutil.GetTLSProfileSpec(hcoutil.GetClusterInfo().GetTLSSecurityProfile(hc.Spec.TLSSecurityProfile))
+
+	protocols, ok := nginxTLSProtocols[profileSpec.MinTLSVersion]
+	if !ok {
+		protocols = nginxTLSProtocols[openshiftconfigv1.VersionTLS12]
+	}
+
+	// nginx uses the OpenSSL cipher names, as in the TLS security profile. The TLS 1.3 cipher suites (TLS_*) are not
+	// configurable by ssl_ciphers.
+	tls12Ciphers := slices.DeleteFunc(slices.Clone(profileSpec.Ciphers), func(cipher string) bool {
+		return strings.HasPrefix(cipher, "TLS_")
+	})
+
+	ciphers := ""
+	if len(tls12Ciphers) > 0 {
+		ciphers = fmt.Sprintf("\t\t\tssl_ciphers         %s;\n\t\t\tssl_prefer_server_ciphers on;\n", strings.Join(tls12Ciphers, ":"))
+	}
+
+	return fmt.Sprintf(nginxConfigTemplate, hcoutil.UIPluginServerPort, protocols, ciphers)
+}
 
 func NewKVUINginxCM(hc *hcov1beta1.HyperConverged) *corev1.ConfigMap {
 	return &corev1.ConfigMap{
@@ -367,7 +406,7 @@ func NewKVUINginxCM(hc *hcov1beta1.HyperConverged) *corev1.ConfigMap {
 			Namespace: hc.Namespace,
 		},
 		Data: map[string]string{
-			"nginx.conf": nginxConfig,
+			"nginx.conf": getNginxConfig(hc),
 		},
 	}
 }
@@ -633,13 +672,11 @@ func newKVConsolePluginNetworkPolicy(hc *hcov1beta1.HyperConverged) *networkingv
 	}
 }
 
-func NewKVConsolePluginNetworkPolicyHandler(_ log.Logger, cli client.Client, schm *runtime.Scheme, hc *hcov1beta1.HyperConverged) (operands.Operand, error) {
-	np := newKVConsolePluginNetworkPolicy(hc)
-
-	return operands.NewNetworkPolicyHandler(cli, schm, np), nil
+func NewKVConsolePluginNetworkPolicyHandler(_ log.Logger, cli client.Client, schm *runtime.Scheme, _ *hcov1beta1.HyperConverged) (operands.Operand, error) {
+	return operands.NewNetworkPolicyHandler(cli, schm, newKVConsolePluginNetworkPolicy), nil
 }
 
-func getApiServerEgressRule() networkingv1.NetworkPolicyEgressRule {
+func getDNSEgressRule() networkingv1.NetworkPolicyEgressRule {
 	var (
 		dnsNamespcaeSelector = k8sDNSNamespaceSelector
 		dnsPodSelectorLabel  = k8sDNSPodSelectorLabel
@@ -707,15 +744,8 @@ func newKVAPIServerProxyNetworkPolicy(hc *hcov1beta1.HyperConverged) *networking
 				},
 			},
 			Egress: []networkingv1.NetworkPolicyEgressRule{
-				getApiServerEgressRule(),
-				{
-					Ports: []networkingv1.NetworkPolicyPort{
-						{
-							Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: apiServerPort},
-							Protocol: ptr.To(corev1.ProtocolTCP),
-						},
-					},
-				},
+				getDNSEgressRule(),
+				getAPIServerEgressRule(hc),
 			},
 			PolicyTypes: []networkingv1.PolicyType{
 				networkingv1.PolicyTypeEgress,
@@ -725,8 +755,6 @@ func newKVAPIServerProxyNetworkPolicy(hc *hcov1beta1.HyperConverged) *networking
 	}
 }
 
-func NewKVAPIServerProxyNetworkPolicyHandler(_ log.Logger, cli client.Client, schm *runtime.Scheme, hc *hcov1beta1.HyperConverged) (operands.Operand, error) {
-	np := newKVAPIServerProxyNetworkPolicy(hc)
-
-	return operands.NewNetworkPolicyHandler(cli, schm, np), nil
+func NewKVAPIServerProxyNetworkPolicyHandler(_ log.Logger, cli client.Client, schm *runtime.Scheme, _ *hcov1beta1.HyperConverged) (operands.Operand, error) {
+	return operands.NewNetworkPolicyHandler(cli, schm, newKVAPIServerProxyNetworkPolicy), nil
 }
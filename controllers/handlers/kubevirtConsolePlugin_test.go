@@ -7,6 +7,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	consolev1 "github.com/openshift/api/console/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -857,6 +858,58 @@ var _ = Describe("Kubevirt Console Plugin", func() {
 		})
 	})
 
+	Context("nginx configuration", func() {
+		It("should use the intermediate TLS profile by default", func() {
+			cm := NewKVUINginxCM(hco)
+			Expect(cm.Data["nginx.conf"]).To(ContainSubstring("ssl_protocols       TLSv1.2 TLSv1.3;"))
+			Expect(cm.Data["nginx.conf"]).To(ContainSubstring("ssl_ciphers         ECDHE-ECDSA-AES128-GCM-SHA256:"))
+		})
+
+		It("should only allow TLS 1.3 with the modern TLS profile", func() {
+			hco.Spec.TLSSecurityProfile = &openshiftconfigv1.TLSSecurityProfile{
+				Type:   openshiftconfigv1.TLSProfileModernType,
+				Modern: &openshiftconfigv1.ModernTLSProfile{},
+			}
+
+			cm := NewKVUINginxCM(hco)
+			Expect(cm.Data["nginx.conf"]).To(ContainSubstring("ssl_protocols       TLSv1.3;"))
+			Expect(cm.Data["nginx.conf"]).ToNot(ContainSubstring("ssl_ciphers"))
+		})
+
+		It("should update the ConfigMap and restart the plugin when the TLS profile is modified", func() {
+			existingCM := NewKVUINginxCM(hco)
+			existingDeployment := NewKvUIPluginDeployment(hco)
+
+			hco.Spec.TLSSecurityProfile = &openshiftconfigv1.TLSSecurityProfile{
+				Type:   openshiftconfigv1.TLSProfileModernType,
+				Modern: &openshiftconfigv1.ModernTLSProfile{},
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco, existingCM, existingDeployment})
+
+			cmHandler, err := NewKvUINginxCMHandler(testLogger, cl, commontestutils.GetScheme(), hco)
+			Expect(err).ToNot(HaveOccurred())
+			res := cmHandler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			deploymentHandler, err := NewKvUIPluginDeploymentHandler(testLogger, cl, commontestutils.GetScheme(), hco)
+			Expect(err).ToNot(HaveOccurred())
+			res = deploymentHandler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			foundCM := &v1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existingCM), foundCM)).To(Succeed())
+			Expect(foundCM.Data["nginx.conf"]).To(ContainSubstring("ssl_protocols       TLSv1.3;"))
+
+			foundDeployment := &appsv1.Deployment{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existingDeployment), foundDeployment)).To(Succeed())
+			Expect(foundDeployment.Spec.Template.Annotations[hcoutil.ConfigHashAnnotation]).ToNot(BeEmpty())
+			Expect(foundDeployment.Spec.Template.Annotations[hcoutil.ConfigHashAnnotation]).ToNot(Equal(existingDeployment.Spec.Template.Annotations[hcoutil.ConfigHashAnnotation]))
+		})
+	})
+
 	Context("Kubevirt Plugin and UI Proxy Service", func() {
 		DescribeTable("should create service if not present", func(appComponent hcoutil.AppComponent,
 			serviceManifestor func(*hcov1beta1.HyperConverged) *v1.Service) {
@@ -28,6 +28,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/passt"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -40,8 +41,11 @@ var _ = Describe("KubeVirt Operand", func() {
 	)
 
 	var (
+		hardCodeKvFgs     = featuregates.MandatoryKubeVirtFeatureGates(true)
+		sspConditionKvFgs = []string{featuregates.KvWithHostModelCPU, featuregates.KvHypervStrictCheck}
+
 		basicNumFgOnOpenshift = len(hardCodeKvFgs) + len(sspConditionKvFgs) + conditionalFeatureGatesCount
-		// Number of featuregates returned by getMandatoryKvFeatureGates (hardcoded + SSP conditional, excludes volume hotplug)
+		// Number of featuregates returned by MandatoryKubeVirtFeatureGates (hardcoded + SSP conditional, excludes volume hotplug)
 		mandatoryFgCount = len(hardCodeKvFgs) + len(sspConditionKvFgs)
 		// Default featuregate count (not Openshift))
 		defaultFeatureGateCount = len(hardCodeKvFgs) + conditionalFeatureGatesCount
@@ -292,7 +296,7 @@ Version: 1.2.3`)).To(Succeed())
 		})
 
 		It("should create if not present", func() {
-			mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
+			mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(false)
 			hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
 				DownwardMetrics: ptr.To(true),
 			}
@@ -467,7 +471,7 @@ Version: 1.2.3`)).To(Succeed())
 		})
 
 		It("should force mandatory configurations", func() {
-			mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
+			mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(false)
 			hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
 				DownwardMetrics: ptr.To(true),
 			}
@@ -618,6 +622,62 @@ Version: 1.2.3`)
 			Expect(kv.Spec.Configuration.ArchitectureConfiguration.S390x).To(BeNil())
 		})
 
+		It("should override the default architecture configuration with the HyperConverged architectureConfiguration", func() {
+			Expect(os.Unsetenv(machineTypeEnvName)).To(Succeed())
+			Expect(os.Setenv(amd64MachineTypeEnvName, "q35")).To(Succeed())
+			Expect(os.Unsetenv(arm64MachineTypeEnvName)).To(Succeed())
+			Expect(os.Setenv(s390xMachineTypeEnvName, "s390-ccw-virtio")).To(Succeed())
+			restArchConfig()
+
+			hco.Spec.ArchitectureConfiguration = &hcov1beta1.ArchitectureConfiguration{
+				Amd64: &hcov1beta1.ArchSpecificConfiguration{
+					MachineType: "pc-q35-rhel9.6.0",
+				},
+				Arm64: &hcov1beta1.ArchSpecificConfiguration{
+					MachineType:      "virt-rhel9.6.0",
+					EmulatedMachines: []string{"virt-rhel*"},
+				},
+			}
+
+			kv, err := NewKubeVirt(hco, commontestutils.Namespace)
+			Expect(err).ToNot(HaveOccurred())
+
+			archConfig := kv.Spec.Configuration.ArchitectureConfiguration
+			Expect(archConfig.Amd64.MachineType).To(Equal("pc-q35-rhel9.6.0"))
+			Expect(archConfig.Amd64.OVMFPath).To(Equal(DefaultAMD64OVMFPath))
+			Expect(archConfig.Amd64.EmulatedMachines).To(Equal([]string{DefaultAMD64EmulatedQ35Machine, DefaultAMD64EmulatedPCQ35Machine}))
+
+			Expect(archConfig.Arm64.MachineType).To(Equal("virt-rhel9.6.0"))
+			Expect(archConfig.Arm64.OVMFPath).To(Equal(DefaultARM64OVMFPath))
+			Expect(archConfig.Arm64.EmulatedMachines).To(Equal([]string{"virt-rhel*"}))
+
+			Expect(archConfig.S390x.MachineType).To(Equal("s390-ccw-virtio"))
+		})
+
+		It("should not modify the default architecture configuration when applying the HyperConverged architectureConfiguration", func() {
+			Expect(os.Unsetenv(machineTypeEnvName)).To(Succeed())
+			Expect(os.Setenv(amd64MachineTypeEnvName, "q35")).To(Succeed())
+			Expect(os.Unsetenv(arm64MachineTypeEnvName)).To(Succeed())
+			Expect(os.Unsetenv(s390xMachineTypeEnvName)).To(Succeed())
+			restArchConfig()
+
+			hco.Spec.ArchitectureConfiguration = &hcov1beta1.ArchitectureConfiguration{
+				Amd64: &hcov1beta1.ArchSpecificConfiguration{
+					OVMFPath: "/custom/OVMF",
+				},
+			}
+
+			kv, err := NewKubeVirt(hco, commontestutils.Namespace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kv.Spec.Configuration.ArchitectureConfiguration.Amd64.MachineType).To(Equal("q35"))
+			Expect(kv.Spec.Configuration.ArchitectureConfiguration.Amd64.OVMFPath).To(Equal("/custom/OVMF"))
+
+			hco.Spec.ArchitectureConfiguration = nil
+			kv, err = NewKubeVirt(hco, commontestutils.Namespace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kv.Spec.Configuration.ArchitectureConfiguration.Amd64.OVMFPath).To(Equal(DefaultAMD64OVMFPath))
+		})
+
 		It("should fail if the SMBIOS is wrongly formatted mandatory configurations", func() {
 			hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
 				WithHostPassthroughCPU: ptr.To(true),
@@ -2159,6 +2219,34 @@ Version: 1.2.3`)
 							Expect(kv.Spec.Configuration.NetworkConfiguration.Binding).ToNot(HaveKey(passt.BindingName))
 						},
 					),
+					Entry("should add the Passt Network Binding to Kubevirt CR if passt is in the HyperConverged networkBindingPlugins",
+						func(hc *hcov1beta1.HyperConverged) {
+							delete(hco.Annotations, passt.DeployPasstNetworkBindingAnnotation)
+							hco.Spec.NetworkBinding = nil
+							hco.Spec.NetworkBindingPlugins = []hcov1beta1.NetworkBindingPlugin{{Name: hcov1beta1.NetworkBindingPluginPasst}}
+						},
+						ContainElement(kvPasstIPStackMigration),
+						func(kv *kubevirtcorev1.KubeVirt) {
+							Expect(kv.Spec.Configuration.NetworkConfiguration).NotTo(BeNil())
+							Expect(kv.Spec.Configuration.NetworkConfiguration.Binding).To(HaveKeyWithValue(passt.BindingName, passt.NetworkBinding()))
+							Expect(kv.Spec.Configuration.NetworkConfiguration.Binding).ToNot(HaveKey(managedTapBindingName))
+						},
+					),
+					Entry("should add the managed tap Network Binding to Kubevirt CR if managedTap is in the HyperConverged networkBindingPlugins",
+						func(hc *hcov1beta1.HyperConverged) {
+							delete(hco.Annotations, passt.DeployPasstNetworkBindingAnnotation)
+							hco.Spec.NetworkBinding = nil
+							hco.Spec.NetworkBindingPlugins = []hcov1beta1.NetworkBindingPlugin{{Name: hcov1beta1.NetworkBindingPluginManagedTap}}
+						},
+						Not(ContainElement(kvPasstIPStackMigration)),
+						func(kv *kubevirtcorev1.KubeVirt) {
+							Expect(kv.Spec.Configuration.NetworkConfiguration).NotTo(BeNil())
+							Expect(kv.Spec.Configuration.NetworkConfiguration.Binding).To(HaveKeyWithValue(managedTapBindingName, kubevirtcorev1.InterfaceBindingPlugin{
+								DomainAttachmentType: kubevirtcorev1.ManagedTap,
+							}))
+							Expect(kv.Spec.Configuration.NetworkConfiguration.Binding).ToNot(HaveKey(passt.BindingName))
+						},
+					),
 					Entry("should add the DeclarativeHotplugVolumes feature gate if DeclarativeHotplugVolumes is true in HyperConverged CR",
 						func(hc *hcov1beta1.HyperConverged) {
 							hc.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
@@ -2301,7 +2389,7 @@ Version: 1.2.3`)
 					).ToNot(HaveOccurred())
 
 					By("KV CR should contain the HC enabled managed feature gates", func() {
-						mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
+						mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(false)
 						Expect(foundResource.Spec.Configuration.DeveloperConfiguration).ToNot(BeNil())
 						fgList := getKvFeatureGateList(&hco.Spec.FeatureGates, nil)
 						Expect(fgList).To(HaveLen(basicNumFgOnOpenshift))
@@ -2332,7 +2420,7 @@ Version: 1.2.3`)
 					).ToNot(HaveOccurred())
 
 					By("KV CR should contain the HC enabled managed feature gates", func() {
-						mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
+						mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(false)
 						Expect(foundResource.Spec.Configuration.DeveloperConfiguration).ToNot(BeNil())
 						fgList := getKvFeatureGateList(&hco.Spec.FeatureGates, nil)
 						Expect(fgList).To(HaveLen(len(getKvFeatureGateList(&hco.Spec.FeatureGates, nil))))
@@ -2342,7 +2430,7 @@ Version: 1.2.3`)
 				})
 
 				It("should keep FG if already exist", func() {
-					mandatoryKvFeatureGates = getMandatoryKvFeatureGates(true)
+					mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(true)
 
 					// Set up HCO with PersistentReservation enabled first
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
@@ -2377,7 +2465,7 @@ Version: 1.2.3`)
 				})
 
 				It("should remove FG if it disabled in HC CR", func() {
-					mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
+					mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(false)
 					existingResource, err := NewKubeVirt(hco)
 					Expect(err).ToNot(HaveOccurred())
 					existingResource.Spec.Configuration.DeveloperConfiguration = &kubevirtcorev1.DeveloperConfiguration{
@@ -2410,7 +2498,7 @@ Version: 1.2.3`)
 				})
 
 				It("should remove FG if it missing from the HC CR", func() {
-					mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
+					mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(false)
 					existingResource, err := NewKubeVirt(hco)
 					Expect(err).ToNot(HaveOccurred())
 					existingResource.Spec.Configuration.DeveloperConfiguration = &kubevirtcorev1.DeveloperConfiguration{
@@ -2441,7 +2529,7 @@ Version: 1.2.3`)
 				})
 
 				It("should remove FG if it the HC CR does not contain the featureGates field", func() {
-					mandatoryKvFeatureGates = getMandatoryKvFeatureGates(true)
+					mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(true)
 					existingResource, err := NewKubeVirt(hco)
 					Expect(err).ToNot(HaveOccurred())
 					existingResource.Spec.Configuration.DeveloperConfiguration = &kubevirtcorev1.DeveloperConfiguration{
@@ -2487,7 +2575,7 @@ Version: 1.2.3`)
 			Context("Test getKvFeatureGateList", func() {
 				DescribeTable("Should return featureGate slice",
 					func(isKVMEmulation bool, fgs *hcov1beta1.HyperConvergedFeatureGates, expectedLength int, expectedFgs [][]string) {
-						mandatoryKvFeatureGates = getMandatoryKvFeatureGates(isKVMEmulation)
+						mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(isKVMEmulation)
 						fgList := getKvFeatureGateList(fgs, nil)
 						Expect(getKvFeatureGateList(fgs, nil)).To(HaveLen(expectedLength))
 						for _, expected := range expectedFgs {
@@ -2532,16 +2620,16 @@ Version: 1.2.3`)
 					))
 			})
 
-			Context("Test getMandatoryKvFeatureGates", func() {
+			Context("Test MandatoryKubeVirtFeatureGates", func() {
 				It("Should include the sspConditionKvFgs if running in openshift", func() {
-					fgs := getMandatoryKvFeatureGates(false)
+					fgs := featuregates.MandatoryKubeVirtFeatureGates(false)
 					Expect(fgs).To(HaveLen(mandatoryFgCount))
 					Expect(fgs).To(ContainElements(hardCodeKvFgs))
 					Expect(fgs).To(ContainElements(sspConditionKvFgs))
 				})
 
 				It("Should not include the sspConditionKvFgs if not running in openshift", func() {
-					fgs := getMandatoryKvFeatureGates(true)
+					fgs := featuregates.MandatoryKubeVirtFeatureGates(true)
 					Expect(fgs).To(HaveLen(len(hardCodeKvFgs)))
 					Expect(fgs).To(ContainElements(hardCodeKvFgs))
 				})
@@ -3776,7 +3864,7 @@ Version: 1.2.3`)
 		})
 
 		Context("jsonpath Annotation", func() {
-			mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
+			mandatoryKvFeatureGates = featuregates.MandatoryKubeVirtFeatureGates(false)
 			It("Should create KV object with changes from the annotation", func() {
 
 				hco.Annotations = map[string]string{common.JSONPatchKVAnnotationName: `[
@@ -4632,5 +4720,5 @@ Version: 1.2.3`)
 
 func restArchConfig() {
 	archConfigOnce = &sync.Once{}
-	getArchConfiguration()
+	getDefaultArchConfiguration()
 }
@@ -1,20 +1,24 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"maps"
 	"reflect"
+	"slices"
+	"strings"
 	"sync"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/utils/net"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	networkaddonsshared "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/shared"
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	networkaddonsnames "github.com/kubevirt/cluster-network-addons-operator/pkg/names"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
@@ -137,30 +141,16 @@ func setDeployOvsAnnotation(req *common.HcoRequest, found *networkaddonsv1.Netwo
 }
 
 func NewNetworkAddons(hc *hcov1beta1.HyperConverged) (*networkaddonsv1.NetworkAddonsConfig, error) {
-	ipam := &networkaddonsshared.KubevirtIpamController{}
-	if util.GetClusterInfo().IsOpenshift() {
-		ipam.DefaultNetworkNADNamespace = "openshift-ovn-kubernetes"
-	}
-
 	cnaoSpec := networkaddonsshared.NetworkAddonsConfigSpec{
-		Multus:                 &networkaddonsshared.Multus{},
-		LinuxBridge:            &networkaddonsshared.LinuxBridge{},
-		KubeMacPool:            hcoKubeMacPool2CnaoKubeMacPool(hc.Spec.KubeMacPoolConfiguration),
-		KubevirtIpamController: ipam,
+		KubeMacPool: hcoKubeMacPool2CnaoKubeMacPool(hc.Spec.KubeMacPoolConfiguration),
 	}
+	hcoNetworking2CnaoSpec(hc.Spec.Networking, &cnaoSpec)
 
-	nameServerIP, err := getKSDNameServerIP(hc.Spec.KubeSecondaryDNSNameServerIP)
+	ksd, err := hcoKubeSecondaryDNS2CnaoKubeSecondaryDNS(hc)
 	if err != nil {
 		return nil, err
 	}
-
-	if hc.Spec.FeatureGates.DeployKubeSecondaryDNS != nil && *hc.Spec.FeatureGates.DeployKubeSecondaryDNS {
-		baseDomain := util.GetClusterInfo().GetBaseDomain()
-		cnaoSpec.KubeSecondaryDNS = &networkaddonsshared.KubeSecondaryDNS{
-			Domain:       baseDomain,
-			NameServerIP: nameServerIP,
-		}
-	}
+	cnaoSpec.KubeSecondaryDNS = ksd
 
 	cnaoSpec.Ovs = hcoAnnotation2CnaoSpec(hc.Annotations)
 	cnaoInfra := hcoConfig2CnaoPlacement(hc.Spec.Infra.NodePlacement)
@@ -185,18 +175,6 @@ func NewNetworkAddons(hc *hcov1beta1.HyperConverged) (*networkaddonsv1.NetworkAd
 	return reformatobj.ReformatObj(cna)
 }
 
-func getKSDNameServerIP(nameServerIPPtr *string) (string, error) {
-	var nameServerIP string
-	if nameServerIPPtr != nil {
-		nameServerIP = *nameServerIPPtr
-		if nameServerIP != "" && !net.IsIPv4String(nameServerIP) {
-			return "", errors.New("kubeSecondaryDNSNameServerIP isn't a valid IPv4")
-		}
-	}
-
-	return nameServerIP, nil
-}
-
 func NewNetworkAddonsWithNameOnly(hc *hcov1beta1.HyperConverged) *networkaddonsv1.NetworkAddonsConfig {
 	return &networkaddonsv1.NetworkAddonsConfig{
 		ObjectMeta: metav1.ObjectMeta{
@@ -235,6 +213,56 @@ func hcoConfig2CnaoPlacement(hcoConf *sdkapi.NodePlacement) *networkaddonsshared
 	return cnaoPlacement
 }
 
+const defaultNetworkNADNamespaceOpenshift = "openshift-ovn-kubernetes"
+
+// hcoNetworking2CnaoSpec sets the network components of the CNAO spec, according to the HyperConverged
+// spec.networking field. Components that are not configured keep their default.
+func hcoNetworking2CnaoSpec(networking *hcov1beta1.NetworkingConfig, cnaoSpec *networkaddonsshared.NetworkAddonsConfigSpec) {
+	if networking == nil {
+		networking = &hcov1beta1.NetworkingConfig{}
+	}
+
+	if shouldDeployNetworkComponent(networking.Multus, true) {
+		cnaoSpec.Multus = &networkaddonsshared.Multus{}
+	}
+
+	if shouldDeployNetworkComponent(networking.MultusDynamicNetworks, false) {
+		cnaoSpec.MultusDynamicNetworks = &networkaddonsshared.MultusDynamicNetworks{}
+	}
+
+	if shouldDeployNetworkComponent(networking.LinuxBridge, true) {
+		cnaoSpec.LinuxBridge = &networkaddonsshared.LinuxBridge{}
+	}
+
+	if macvtap := networking.Macvtap; macvtap != nil && shouldDeployNetworkComponent(&macvtap.NetworkComponentConfig, false) {
+		cnaoSpec.MacvtapCni = &networkaddonsshared.MacvtapCni{
+			DevicePluginConfig: macvtap.DevicePluginConfig,
+		}
+	}
+
+	ipamConfig := networking.KubevirtIpamController
+	if ipamConfig == nil {
+		ipamConfig = &hcov1beta1.KubevirtIpamControllerConfig{}
+	}
+
+	if shouldDeployNetworkComponent(&ipamConfig.NetworkComponentConfig, true) {
+		ipam := &networkaddonsshared.KubevirtIpamController{
+			DefaultNetworkNADNamespace: ipamConfig.DefaultNetworkNADNamespace,
+		}
+		if ipam.DefaultNetworkNADNamespace == "" && util.GetClusterInfo().IsOpenshift() {
+			ipam.DefaultNetworkNADNamespace = defaultNetworkNADNamespaceOpenshift
+		}
+		cnaoSpec.KubevirtIpamController = ipam
+	}
+}
+
+func shouldDeployNetworkComponent(config *hcov1beta1.NetworkComponentConfig, defaultValue bool) bool {
+	if config == nil {
+		return defaultValue
+	}
+	return ptr.Deref(config.Deploy, defaultValue)
+}
+
 func hcoAnnotation2CnaoSpec(hcoAnnotations map[string]string) *networkaddonsshared.Ovs {
 	val, exists := hcoAnnotations["deployOVS"]
 	if exists && val == "true" {
@@ -247,6 +275,11 @@ func hcoKubeMacPool2CnaoKubeMacPool(hcoKubeMacPool *hcov1beta1.KubeMacPoolConfig
 	kubeMacPool := &networkaddonsshared.KubeMacPool{}
 
 	if hcoKubeMacPool != nil {
+		// the webhook rejects more than a single range, as CNAO does not support multiple ranges yet
+		if len(hcoKubeMacPool.Ranges) > 0 {
+			kubeMacPool.RangeStart = hcoKubeMacPool.Ranges[0].Start
+			kubeMacPool.RangeEnd = hcoKubeMacPool.Ranges[0].End
+		}
 		if hcoKubeMacPool.RangeStart != nil {
 			kubeMacPool.RangeStart = *hcoKubeMacPool.RangeStart
 		}
@@ -283,3 +316,95 @@ func hcoCertConfig2CnaoSelfSignedConfig(hcoCertConfig *hcov1beta1.HyperConverged
 		CertOverlapInterval: certOverlapInterval,
 	}
 }
+
+// networkComponent is a network component that HCO configures in the NetworkAddonsConfig
+type networkComponent struct {
+	name hcov1beta1.NetworkComponentName
+	// workloads are the names of the DaemonSets and Deployments that CNAO deploys for the component
+	workloads []string
+	// isDeployed returns true if the component is deployed by the NetworkAddonsConfig spec
+	isDeployed func(spec *networkaddonsshared.NetworkAddonsConfigSpec) bool
+}
+
+var networkComponents = []networkComponent{
+	{
+		name:       hcov1beta1.NetworkComponentMultus,
+		workloads:  []string{"multus"},
+		isDeployed: func(spec *networkaddonsshared.NetworkAddonsConfigSpec) bool { return spec.Multus != nil },
+	},
+	{
+		name:       hcov1beta1.NetworkComponentMultusDynamicNetworks,
+		workloads:  []string{"dynamic-networks-controller-ds"},
+		isDeployed: func(spec *networkaddonsshared.NetworkAddonsConfigSpec) bool { return spec.MultusDynamicNetworks != nil },
+	},
+	{
+		name:       hcov1beta1.NetworkComponentLinuxBridge,
+		workloads:  []string{"kube-cni-linux-bridge-plugin", "bridge-marker"},
+		isDeployed: func(spec *networkaddonsshared.NetworkAddonsConfigSpec) bool { return spec.LinuxBridge != nil },
+	},
+	{
+		name:       hcov1beta1.NetworkComponentMacvtap,
+		workloads:  []string{"macvtap-cni"},
+		isDeployed: func(spec *networkaddonsshared.NetworkAddonsConfigSpec) bool { return spec.MacvtapCni != nil },
+	},
+	{
+		name:      hcov1beta1.NetworkComponentKubevirtIpamController,
+		workloads: []string{"kubevirt-ipam-controller-manager"},
+		isDeployed: func(spec *networkaddonsshared.NetworkAddonsConfigSpec) bool {
+			return spec.KubevirtIpamController != nil
+		},
+	},
+}
+
+// GetNetworkComponentsStatus returns the state of the network components that are deployed by the NetworkAddonsConfig.
+// CNAO only reports conditions for the whole NetworkAddonsConfig; a component is not ready if the NetworkAddonsConfig
+// is not available, or if its Degraded or Progressing condition refers to one of the component workloads.
+func GetNetworkComponentsStatus(ctx context.Context, cli client.Reader, hc *hcov1beta1.HyperConverged) []hcov1beta1.NetworkComponentStatus {
+	cna := NewNetworkAddonsWithNameOnly(hc)
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(cna), cna); err != nil {
+		return nil
+	}
+
+	conditions := cna.Status.Conditions
+	available := conditionsv1.FindStatusCondition(conditions, conditionsv1.ConditionAvailable)
+	degraded := conditionsv1.FindStatusCondition(conditions, conditionsv1.ConditionDegraded)
+	progressing := conditionsv1.FindStatusCondition(conditions, conditionsv1.ConditionProgressing)
+
+	var statuses []hcov1beta1.NetworkComponentStatus
+	for _, component := range networkComponents {
+		if !component.isDeployed(&cna.Spec) {
+			continue
+		}
+
+		status := hcov1beta1.NetworkComponentStatus{Name: component.name}
+		switch {
+		case component.isReferredBy(degraded):
+			status.Message = degraded.Message
+		case component.isReferredBy(progressing):
+			status.Message = progressing.Message
+		case available == nil || available.Status != corev1.ConditionTrue:
+			status.Message = "the NetworkAddonsConfig is not available"
+			if available != nil && available.Message != "" {
+				status.Message += ": " + available.Message
+			}
+		default:
+			status.Ready = true
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// isReferredBy returns true if the condition is true, and its message refers to one of the component workloads. CNAO
+// refers to the workloads as "<namespace>/<name>".
+func (c networkComponent) isReferredBy(condition *conditionsv1.Condition) bool {
+	if condition == nil || condition.Status != corev1.ConditionTrue {
+		return false
+	}
+
+	return slices.ContainsFunc(c.workloads, func(workload string) bool {
+		return strings.Contains(condition.Message, "/"+workload+`"`)
+	})
+}
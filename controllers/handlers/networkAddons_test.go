@@ -62,6 +62,19 @@ var _ = Describe("CNA Operand", func() {
 			Expect(foundResource.Spec.KubevirtIpamController).To(Equal(&networkaddonsshared.KubevirtIpamController{}))
 		})
 
+		It("should set the KubeMacPool range from the ranges list", func() {
+			hco.Spec.KubeMacPoolConfiguration = &hcov1beta1.KubeMacPoolConfig{
+				Ranges: []hcov1beta1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:FF:FF:FF"}},
+			}
+
+			cna, err := NewNetworkAddons(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cna.Spec.KubeMacPool).To(Equal(&networkaddonsshared.KubeMacPool{
+				RangeStart: "02:00:00:00:00:00",
+				RangeEnd:   "02:00:00:FF:FF:FF",
+			}))
+		})
+
 		It("should find if present", func() {
 			expectedResource, err := NewNetworkAddons(hco)
 			Expect(err).ToNot(HaveOccurred())
@@ -590,8 +603,8 @@ var _ = Describe("CNA Operand", func() {
 
 		type ksdAnnotationParams struct {
 			ksdExists          bool
-			setFeatureGate     bool
-			featureGateValue   bool
+			setDeploy          bool
+			deployValue        bool
 			ksdDeployExpected  bool
 			expectedBaseDomain string
 		}
@@ -604,9 +617,11 @@ var _ = Describe("CNA Operand", func() {
 			}
 
 			const kubeSecondaryDNSNameServerIP = "127.0.0.1"
-			if o.setFeatureGate {
-				hco.Spec.FeatureGates.DeployKubeSecondaryDNS = ptr.To(o.featureGateValue)
-				hco.Spec.KubeSecondaryDNSNameServerIP = ptr.To(kubeSecondaryDNSNameServerIP)
+			if o.setDeploy {
+				hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+					Deploy:       ptr.To(o.deployValue),
+					NameServerIP: kubeSecondaryDNSNameServerIP,
+				}
 			}
 
 			cl := commontestutils.InitClient([]client.Object{hco, existingCNAO})
@@ -635,24 +650,24 @@ var _ = Describe("CNA Operand", func() {
 
 		Context("With K8s", func() {
 			DescribeTable("when reconciling kube-secondary-dns", ksdTester,
-				Entry("should have KSD if feature gate is set to true", ksdAnnotationParams{
+				Entry("should have KSD if spec.kubeSecondaryDNS.deploy is set to true", ksdAnnotationParams{
 					ksdExists:          false,
-					setFeatureGate:     true,
-					featureGateValue:   true,
+					setDeploy:          true,
+					deployValue:        true,
 					ksdDeployExpected:  true,
 					expectedBaseDomain: "",
 				}),
-				Entry("should not have KSD if feature gate is set to false", ksdAnnotationParams{
+				Entry("should not have KSD if spec.kubeSecondaryDNS.deploy is set to false", ksdAnnotationParams{
 					ksdExists:          true,
-					setFeatureGate:     true,
-					featureGateValue:   false,
+					setDeploy:          true,
+					deployValue:        false,
 					ksdDeployExpected:  false,
 					expectedBaseDomain: "",
 				}),
-				Entry("should not have KSD if feature gate does not exist", ksdAnnotationParams{
+				Entry("should not have KSD if spec.kubeSecondaryDNS is not set", ksdAnnotationParams{
 					ksdExists:          true,
-					setFeatureGate:     false,
-					featureGateValue:   false,
+					setDeploy:          false,
+					deployValue:        false,
 					ksdDeployExpected:  false,
 					expectedBaseDomain: "",
 				}),
@@ -673,24 +688,24 @@ var _ = Describe("CNA Operand", func() {
 			})
 
 			DescribeTable("when reconciling kube-secondary-dns", ksdTester,
-				Entry("should have KSD if feature gate is set to true", ksdAnnotationParams{
+				Entry("should have KSD if spec.kubeSecondaryDNS.deploy is set to true", ksdAnnotationParams{
 					ksdExists:          false,
-					setFeatureGate:     true,
-					featureGateValue:   true,
+					setDeploy:          true,
+					deployValue:        true,
 					ksdDeployExpected:  true,
 					expectedBaseDomain: commontestutils.BaseDomain,
 				}),
-				Entry("should not have KSD if feature gate is set to false", ksdAnnotationParams{
+				Entry("should not have KSD if spec.kubeSecondaryDNS.deploy is set to false", ksdAnnotationParams{
 					ksdExists:          true,
-					setFeatureGate:     true,
-					featureGateValue:   false,
+					setDeploy:          true,
+					deployValue:        false,
 					ksdDeployExpected:  false,
 					expectedBaseDomain: "",
 				}),
-				Entry("should not have KSD if feature gate does not exist", ksdAnnotationParams{
+				Entry("should not have KSD if spec.kubeSecondaryDNS is not set", ksdAnnotationParams{
 					ksdExists:          true,
-					setFeatureGate:     false,
-					featureGateValue:   false,
+					setDeploy:          false,
+					deployValue:        false,
 					ksdDeployExpected:  false,
 					expectedBaseDomain: "",
 				}),
@@ -1052,6 +1067,137 @@ var _ = Describe("CNA Operand", func() {
 
 		})
 
+		Context("Networking", func() {
+			It("should deploy the default network components when networking is not set", func() {
+				hco.Spec.Networking = nil
+
+				cr, err := NewNetworkAddons(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cr.Spec.Multus).ToNot(BeNil())
+				Expect(cr.Spec.LinuxBridge).ToNot(BeNil())
+				Expect(cr.Spec.KubevirtIpamController).ToNot(BeNil())
+				Expect(cr.Spec.MultusDynamicNetworks).To(BeNil())
+				Expect(cr.Spec.MacvtapCni).To(BeNil())
+			})
+
+			It("should deploy the opt-in network components with their settings", func() {
+				hco.Spec.Networking = &hcov1beta1.NetworkingConfig{
+					MultusDynamicNetworks: &hcov1beta1.NetworkComponentConfig{Deploy: ptr.To(true)},
+					Macvtap: &hcov1beta1.MacvtapConfig{
+						NetworkComponentConfig: hcov1beta1.NetworkComponentConfig{Deploy: ptr.To(true)},
+						DevicePluginConfig:     "macvtap-deviceplugin-config",
+					},
+					KubevirtIpamController: &hcov1beta1.KubevirtIpamControllerConfig{
+						DefaultNetworkNADNamespace: "custom-namespace",
+					},
+				}
+
+				cr, err := NewNetworkAddons(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cr.Spec.Multus).ToNot(BeNil())
+				Expect(cr.Spec.LinuxBridge).ToNot(BeNil())
+				Expect(cr.Spec.MultusDynamicNetworks).ToNot(BeNil())
+				Expect(cr.Spec.MacvtapCni).To(Equal(&networkaddonsshared.MacvtapCni{DevicePluginConfig: "macvtap-deviceplugin-config"}))
+				Expect(cr.Spec.KubevirtIpamController).To(Equal(&networkaddonsshared.KubevirtIpamController{DefaultNetworkNADNamespace: "custom-namespace"}))
+			})
+
+			It("should not deploy the network components that are disabled", func() {
+				hco.Spec.Networking = &hcov1beta1.NetworkingConfig{
+					Multus:      &hcov1beta1.NetworkComponentConfig{Deploy: ptr.To(false)},
+					LinuxBridge: &hcov1beta1.NetworkComponentConfig{Deploy: ptr.To(false)},
+					Macvtap: &hcov1beta1.MacvtapConfig{
+						DevicePluginConfig: "macvtap-deviceplugin-config",
+					},
+					KubevirtIpamController: &hcov1beta1.KubevirtIpamControllerConfig{
+						NetworkComponentConfig: hcov1beta1.NetworkComponentConfig{Deploy: ptr.To(false)},
+					},
+				}
+
+				cr, err := NewNetworkAddons(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cr.Spec.Multus).To(BeNil())
+				Expect(cr.Spec.LinuxBridge).To(BeNil())
+				Expect(cr.Spec.MultusDynamicNetworks).To(BeNil())
+				Expect(cr.Spec.MacvtapCni).To(BeNil())
+				Expect(cr.Spec.KubevirtIpamController).To(BeNil())
+			})
+
+			It("when running on openshift, it should not override the configured default network NAD namespace", func() {
+				getClusterInfo := hcoutil.GetClusterInfo
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo { return &commontestutils.ClusterInfoMock{} }
+				defer func() { hcoutil.GetClusterInfo = getClusterInfo }()
+
+				hco.Spec.Networking = &hcov1beta1.NetworkingConfig{
+					KubevirtIpamController: &hcov1beta1.KubevirtIpamControllerConfig{
+						DefaultNetworkNADNamespace: "custom-namespace",
+					},
+				}
+
+				cr, err := NewNetworkAddons(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cr.Spec.KubevirtIpamController.DefaultNetworkNADNamespace).To(Equal("custom-namespace"))
+			})
+		})
+
+		Context("GetNetworkComponentsStatus", func() {
+			newCna := func(conditions ...conditionsv1.Condition) *networkaddonsv1.NetworkAddonsConfig {
+				hco.Spec.Networking = &hcov1beta1.NetworkingConfig{
+					Macvtap: &hcov1beta1.MacvtapConfig{
+						NetworkComponentConfig: hcov1beta1.NetworkComponentConfig{Deploy: ptr.To(true)},
+					},
+				}
+				cna, err := NewNetworkAddons(hco)
+				Expect(err).ToNot(HaveOccurred())
+				cna.Status.Conditions = conditions
+				return cna
+			}
+
+			It("should not report any component if the NetworkAddonsConfig does not exist", func() {
+				cl := commontestutils.InitClient([]client.Object{hco})
+				Expect(GetNetworkComponentsStatus(context.TODO(), cl, hco)).To(BeEmpty())
+			})
+
+			It("should report all the deployed components as ready, if the NetworkAddonsConfig is available", func() {
+				cna := newCna(conditionsv1.Condition{Type: conditionsv1.ConditionAvailable, Status: corev1.ConditionTrue})
+				cl := commontestutils.InitClient([]client.Object{hco, cna})
+
+				Expect(GetNetworkComponentsStatus(context.TODO(), cl, hco)).To(Equal([]hcov1beta1.NetworkComponentStatus{
+					{Name: hcov1beta1.NetworkComponentMultus, Ready: true},
+					{Name: hcov1beta1.NetworkComponentLinuxBridge, Ready: true},
+					{Name: hcov1beta1.NetworkComponentMacvtap, Ready: true},
+					{Name: hcov1beta1.NetworkComponentKubevirtIpamController, Ready: true},
+				}))
+			})
+
+			It("should only report the components that the conditions refer to as not ready", func() {
+				const degradedMessage = `DaemonSet "cluster-network-addons/macvtap-cni" rollout is not making progress`
+				cna := newCna(
+					conditionsv1.Condition{Type: conditionsv1.ConditionAvailable, Status: corev1.ConditionTrue},
+					conditionsv1.Condition{Type: conditionsv1.ConditionDegraded, Status: corev1.ConditionTrue, Message: degradedMessage},
+				)
+				cl := commontestutils.InitClient([]client.Object{hco, cna})
+
+				Expect(GetNetworkComponentsStatus(context.TODO(), cl, hco)).To(Equal([]hcov1beta1.NetworkComponentStatus{
+					{Name: hcov1beta1.NetworkComponentMultus, Ready: true},
+					{Name: hcov1beta1.NetworkComponentLinuxBridge, Ready: true},
+					{Name: hcov1beta1.NetworkComponentMacvtap, Message: degradedMessage},
+					{Name: hcov1beta1.NetworkComponentKubevirtIpamController, Ready: true},
+				}))
+			})
+
+			It("should report all the components as not ready, if the NetworkAddonsConfig is not available", func() {
+				cna := newCna(conditionsv1.Condition{Type: conditionsv1.ConditionAvailable, Status: corev1.ConditionFalse, Message: "some message"})
+				cl := commontestutils.InitClient([]client.Object{hco, cna})
+
+				statuses := GetNetworkComponentsStatus(context.TODO(), cl, hco)
+				Expect(statuses).To(HaveLen(4))
+				for _, status := range statuses {
+					Expect(status.Ready).To(BeFalse())
+					Expect(status.Message).To(Equal("the NetworkAddonsConfig is not available: some message"))
+				}
+			})
+		})
+
 		Context("Cache", func() {
 			It("should create new cache if it empty", func() {
 				hook := &cnaHooks{}
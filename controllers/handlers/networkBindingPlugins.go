@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/networkbinding"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/passt"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+const managedTapBindingName = "managedtap"
+
+// managedTapPlugin is the managed tap network binding. KubeVirt creates the tap device itself, so the binding does
+// not require any component but the KubeVirt binding entry.
+var managedTapPlugin = &networkbinding.Plugin{
+	Name:        hcov1beta1.NetworkBindingPluginManagedTap,
+	BindingName: managedTapBindingName,
+	Binding: func() kubevirtcorev1.InterfaceBindingPlugin {
+		return kubevirtcorev1.InterfaceBindingPlugin{
+			DomainAttachmentType: kubevirtcorev1.ManagedTap,
+		}
+	},
+}
+
+// managedNetworkBindingPlugins are the network binding plugins that HCO can deploy. A new plugin only needs to be
+// added to this list.
+var managedNetworkBindingPlugins = []*networkbinding.Plugin{
+	passt.Plugin,
+	managedTapPlugin,
+}
+
+// GetManagedNetworkBindingPlugin returns the managed network binding plugin with the given name
+func GetManagedNetworkBindingPlugin(name hcov1beta1.NetworkBindingPluginName) (*networkbinding.Plugin, bool) {
+	for _, plugin := range managedNetworkBindingPlugins {
+		if plugin.Name == name {
+			return plugin, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetEnabledNetworkBindingPlugins returns the managed network binding plugins that are enabled in the HyperConverged CR
+func GetEnabledNetworkBindingPlugins(hc *hcov1beta1.HyperConverged) []*networkbinding.Plugin {
+	var plugins []*networkbinding.Plugin
+	for _, plugin := range managedNetworkBindingPlugins {
+		if plugin.IsEnabled(hc) {
+			plugins = append(plugins, plugin)
+		}
+	}
+
+	return plugins
+}
+
+// GetNetworkBindingPluginHandlers returns the handlers of the components of all the managed network binding plugins
+func GetNetworkBindingPluginHandlers(cli client.Client, scheme *runtime.Scheme, isOpenshift bool) []operands.Operand {
+	var pluginHandlers []operands.Operand
+	for _, plugin := range managedNetworkBindingPlugins {
+		pluginHandlers = append(pluginHandlers, plugin.GetHandlers(cli, scheme, isOpenshift)...)
+	}
+
+	return pluginHandlers
+}
+
+// GetNetworkBindingPluginObjectsToDelete returns the components of the managed network binding plugins, that should
+// be removed when the HyperConverged CR is deleted
+func GetNetworkBindingPluginObjectsToDelete(hc *hcov1beta1.HyperConverged) []client.Object {
+	var objects []client.Object
+	for _, plugin := range managedNetworkBindingPlugins {
+		objects = append(objects, plugin.GetObjectsToDelete(hc)...)
+	}
+
+	return objects
+}
+
+// GetNetworkBindingPluginsStatus returns the state of the enabled network binding plugins
+func GetNetworkBindingPluginsStatus(ctx context.Context, cli client.Reader, hc *hcov1beta1.HyperConverged) []hcov1beta1.NetworkBindingPluginStatus {
+	var statuses []hcov1beta1.NetworkBindingPluginStatus
+	for _, plugin := range GetEnabledNetworkBindingPlugins(hc) {
+		statuses = append(statuses, plugin.GetStatus(ctx, cli, hc))
+	}
+
+	return statuses
+}
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	waspagent "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/wasp-agent"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	allowAllNetworkPolicyName            = "hco-allow-all"
+	extraIngressNetworkPolicyName        = "hco-allow-extra-ingress"
+	extraEgressNetworkPolicyName         = "hco-allow-extra-egress"
+	customAPIServerNetworkPolicyName     = "hco-allow-egress-to-custom-api-server"
+	prometheusNamespaceNetworkPolicyName = "hco-allow-prometheus-namespace"
+)
+
+// IsNetworkPoliciesDisabled returns true if the NetworkPolicies of the HCO pods are disabled by the user
+func IsNetworkPoliciesDisabled(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.NetworkPolicies != nil && ptr.Deref(hc.Spec.NetworkPolicies.Disabled, false)
+}
+
+// GetNetworkPolicyHandlers returns the handlers of the NetworkPolicies that extend the NetworkPolicies of the HCO
+// pods, according to spec.networkPolicies. NetworkPolicies are additive, so each one of them only allows more traffic.
+// A NetworkPolicy is removed if its field is not set.
+func GetNetworkPolicyHandlers(cli client.Client, scheme *runtime.Scheme) []operands.Operand {
+	return []operands.Operand{
+		newConditionalNetworkPolicyHandler(cli, scheme, IsNetworkPoliciesDisabled, newAllowAllNetworkPolicy),
+		newConditionalNetworkPolicyHandler(cli, scheme, hasExtraIngressPeers, newExtraIngressNetworkPolicy),
+		newConditionalNetworkPolicyHandler(cli, scheme, hasExtraEgressPeers, newExtraEgressNetworkPolicy),
+		newConditionalNetworkPolicyHandler(cli, scheme, hasCustomAPIServer, newCustomAPIServerNetworkPolicy),
+		newConditionalNetworkPolicyHandler(cli, scheme, hasPrometheusNamespace, newPrometheusNamespaceNetworkPolicy),
+	}
+}
+
+func newConditionalNetworkPolicyHandler(cli client.Client, scheme *runtime.Scheme, shouldDeploy operands.ConditionFunc, newNP func(*hcov1beta1.HyperConverged) *networkingv1.NetworkPolicy) operands.Operand {
+	return operands.NewConditionalHandler(
+		operands.NewNetworkPolicyHandler(cli, scheme, newNP),
+		shouldDeploy,
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return newNP(hc)
+		},
+	)
+}
+
+// getNetworkPoliciesConfig returns spec.networkPolicies, or an empty configuration if it is not set; the
+// NetworkPolicies are also built when they are removed.
+func getNetworkPoliciesConfig(hc *hcov1beta1.HyperConverged) hcov1beta1.NetworkPoliciesConfig {
+	return ptr.Deref(hc.Spec.NetworkPolicies, hcov1beta1.NetworkPoliciesConfig{})
+}
+
+func hasExtraIngressPeers(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.NetworkPolicies != nil && len(hc.Spec.NetworkPolicies.ExtraIngressPeers) > 0
+}
+
+func hasExtraEgressPeers(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.NetworkPolicies != nil && len(hc.Spec.NetworkPolicies.ExtraEgressPeers) > 0
+}
+
+func hasCustomAPIServer(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.NetworkPolicies != nil && hc.Spec.NetworkPolicies.APIServer != nil &&
+		(len(hc.Spec.NetworkPolicies.APIServer.CIDRBlocks) > 0 || len(hc.Spec.NetworkPolicies.APIServer.Ports) > 0)
+}
+
+func hasPrometheusNamespace(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.NetworkPolicies != nil && hc.Spec.NetworkPolicies.PrometheusNamespace != ""
+}
+
+// getAPIServerEgressRule returns the egress rule that allows access to the API server; by default, to port 6443 of
+// any address.
+func getAPIServerEgressRule(hc *hcov1beta1.HyperConverged) networkingv1.NetworkPolicyEgressRule {
+	ports := []int32{apiServerPort}
+	var cidrBlocks []string
+	if hc.Spec.NetworkPolicies != nil && hc.Spec.NetworkPolicies.APIServer != nil {
+		if len(hc.Spec.NetworkPolicies.APIServer.Ports) > 0 {
+			ports = hc.Spec.NetworkPolicies.APIServer.Ports
+		}
+		cidrBlocks = hc.Spec.NetworkPolicies.APIServer.CIDRBlocks
+	}
+
+	rule := networkingv1.NetworkPolicyEgressRule{}
+	for _, port := range ports {
+		rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{
+			Port:     ptr.To(intstr.FromInt32(port)),
+			Protocol: ptr.To(corev1.ProtocolTCP),
+		})
+	}
+
+	for _, cidr := range cidrBlocks {
+		rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+		})
+	}
+
+	return rule
+}
+
+func newHCONetworkPolicy(hc *hcov1beta1.HyperConverged, name string, podSelector metav1.LabelSelector) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: hc.Namespace,
+			Labels:    operands.GetLabels(hc, hcoutil.AppComponentDeployment),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: podSelector,
+		},
+	}
+}
+
+// podsWithLabel selects the HCO pods with the np.kubevirt.io/* label
+func podsWithLabel(label string) metav1.LabelSelector {
+	return metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      label,
+				Operator: metav1.LabelSelectorOpExists,
+			},
+		},
+	}
+}
+
+// hcoPods selects the pods that HCO deploys itself: the operator, the webhook, the CLI downloads, the console plugin,
+// the console proxy and the wasp agent. The operand pods, and any other pod in the namespace, are not selected, so
+// their NetworkPolicies are not affected.
+func hcoPods() metav1.LabelSelector {
+	return metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			hcoutil.AppLabelPartOf: hcoutil.HyperConvergedCluster,
+		},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      hcoutil.AppLabelComponent,
+				Operator: metav1.LabelSelectorOpIn,
+				Values: []string{
+					string(hcoutil.AppComponentDeployment),
+					string(hcoutil.AppComponentUIPlugin),
+					string(hcoutil.AppComponentUIProxy),
+					waspagent.AppComponentWaspAgent,
+				},
+			},
+		},
+	}
+}
+
+func newAllowAllNetworkPolicy(hc *hcov1beta1.HyperConverged) *networkingv1.NetworkPolicy {
+	np := newHCONetworkPolicy(hc, allowAllNetworkPolicyName, hcoPods())
+	np.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{}}
+	np.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{{}}
+	np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}
+
+	return np
+}
+
+func newExtraIngressNetworkPolicy(hc *hcov1beta1.HyperConverged) *networkingv1.NetworkPolicy {
+	np := newHCONetworkPolicy(hc, extraIngressNetworkPolicyName, podsWithLabel(hcoutil.AllowIngressToMetricsEndpointLabel))
+	np.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{
+		{From: getNetworkPoliciesConfig(hc).ExtraIngressPeers},
+	}
+	np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+
+	return np
+}
+
+func newExtraEgressNetworkPolicy(hc *hcov1beta1.HyperConverged) *networkingv1.NetworkPolicy {
+	np := newHCONetworkPolicy(hc, extraEgressNetworkPolicyName, podsWithLabel(hcoutil.AllowEgressToDNSAndAPIServerLabel))
+	np.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{
+		{To: getNetworkPoliciesConfig(hc).ExtraEgressPeers},
+	}
+	np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}
+
+	return np
+}
+
+func newCustomAPIServerNetworkPolicy(hc *hcov1beta1.HyperConverged) *networkingv1.NetworkPolicy {
+	np := newHCONetworkPolicy(hc, customAPIServerNetworkPolicyName, podsWithLabel(hcoutil.AllowEgressToDNSAndAPIServerLabel))
+	np.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{
+		getAPIServerEgressRule(hc),
+	}
+	np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}
+
+	return np
+}
+
+func newPrometheusNamespaceNetworkPolicy(hc *hcov1beta1.HyperConverged) *networkingv1.NetworkPolicy {
+	peers := []networkingv1.NetworkPolicyPeer{
+		{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					hcoutil.KubernetesMetadataName: getNetworkPoliciesConfig(hc).PrometheusNamespace,
+				},
+			},
+		},
+	}
+
+	np := newHCONetworkPolicy(hc, prometheusNamespaceNetworkPolicyName, podsWithLabel(hcoutil.AllowIngressToMetricsEndpointLabel))
+	np.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{From: peers}}
+	np.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{{To: peers}}
+	np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}
+
+	return np
+}
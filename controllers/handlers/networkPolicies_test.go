@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("NetworkPolicy extensions", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	ensureAll := func(handlers []operands.Operand) {
+		GinkgoHelper()
+		for _, handler := range handlers {
+			Expect(handler.Ensure(req).Err).ToNot(HaveOccurred())
+		}
+	}
+
+	getNP := func(cl client.Client, name string) (*networkingv1.NetworkPolicy, error) {
+		np := &networkingv1.NetworkPolicy{}
+		err := cl.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: hco.Namespace}, np)
+		return np, err
+	}
+
+	It("should not deploy any NetworkPolicy by default", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+		ensureAll(GetNetworkPolicyHandlers(cl, commontestutils.GetScheme()))
+
+		npList := &networkingv1.NetworkPolicyList{}
+		Expect(cl.List(context.TODO(), npList)).To(Succeed())
+		Expect(npList.Items).To(BeEmpty())
+	})
+
+	It("should allow all the traffic when the NetworkPolicies are disabled", func() {
+		hco.Spec.NetworkPolicies = &hcov1beta1.NetworkPoliciesConfig{Disabled: ptr.To(true)}
+		cl := commontestutils.InitClient([]client.Object{hco})
+		ensureAll(GetNetworkPolicyHandlers(cl, commontestutils.GetScheme()))
+
+		np, err := getNP(cl, allowAllNetworkPolicyName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(np.Spec.PodSelector.MatchLabels).To(Equal(map[string]string{hcoutil.AppLabelPartOf: hcoutil.HyperConvergedCluster}))
+		Expect(np.Spec.PodSelector.MatchExpressions).To(HaveLen(1))
+		Expect(np.Spec.PodSelector.MatchExpressions[0].Key).To(Equal(hcoutil.AppLabelComponent))
+		Expect(np.Spec.PodSelector.MatchExpressions[0].Values).To(ContainElement(string(hcoutil.AppComponentDeployment)))
+		Expect(np.Spec.PodSelector.MatchExpressions[0].Values).ToNot(ContainElement(string(hcoutil.AppComponentCompute)))
+		Expect(np.Spec.Ingress).To(Equal([]networkingv1.NetworkPolicyIngressRule{{}}))
+		Expect(np.Spec.Egress).To(Equal([]networkingv1.NetworkPolicyEgressRule{{}}))
+		Expect(np.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+	})
+
+	It("should add the extra ingress and egress peers", func() {
+		ingressPeer := networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"monitoring": "true"}},
+		}
+		egressPeer := networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: "192.0.2.0/24"},
+		}
+		hco.Spec.NetworkPolicies = &hcov1beta1.NetworkPoliciesConfig{
+			ExtraIngressPeers: []networkingv1.NetworkPolicyPeer{ingressPeer},
+			ExtraEgressPeers:  []networkingv1.NetworkPolicyPeer{egressPeer},
+		}
+		cl := commontestutils.InitClient([]client.Object{hco})
+		ensureAll(GetNetworkPolicyHandlers(cl, commontestutils.GetScheme()))
+
+		np, err := getNP(cl, extraIngressNetworkPolicyName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(np.Spec.PodSelector.MatchExpressions[0].Key).To(Equal(hcoutil.AllowIngressToMetricsEndpointLabel))
+		Expect(np.Spec.Ingress).To(Equal([]networkingv1.NetworkPolicyIngressRule{{From: []networkingv1.NetworkPolicyPeer{ingressPeer}}}))
+		Expect(np.Spec.PolicyTypes).To(Equal([]networkingv1.PolicyType{networkingv1.PolicyTypeIngress}))
+
+		np, err = getNP(cl, extraEgressNetworkPolicyName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(np.Spec.PodSelector.MatchExpressions[0].Key).To(Equal(hcoutil.AllowEgressToDNSAndAPIServerLabel))
+		Expect(np.Spec.Egress).To(Equal([]networkingv1.NetworkPolicyEgressRule{{To: []networkingv1.NetworkPolicyPeer{egressPeer}}}))
+		Expect(np.Spec.PolicyTypes).To(Equal([]networkingv1.PolicyType{networkingv1.PolicyTypeEgress}))
+
+		_, err = getNP(cl, allowAllNetworkPolicyName)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should allow the custom API server addresses and ports", func() {
+		hco.Spec.NetworkPolicies = &hcov1beta1.NetworkPoliciesConfig{
+			APIServer: &hcov1beta1.NetworkPoliciesAPIServer{
+				CIDRBlocks: []string{"198.51.100.10/32"},
+				Ports:      []int32{443, 6443},
+			},
+		}
+		cl := commontestutils.InitClient([]client.Object{hco})
+		ensureAll(GetNetworkPolicyHandlers(cl, commontestutils.GetScheme()))
+
+		np, err := getNP(cl, customAPIServerNetworkPolicyName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(np.Spec.Egress).To(Equal([]networkingv1.NetworkPolicyEgressRule{
+			{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Port: ptr.To(intstr.FromInt32(443)), Protocol: ptr.To(corev1.ProtocolTCP)},
+					{Port: ptr.To(intstr.FromInt32(6443)), Protocol: ptr.To(corev1.ProtocolTCP)},
+				},
+				To: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "198.51.100.10/32"}},
+				},
+			},
+		}))
+	})
+
+	It("should use the custom API server ports in the api-server proxy NetworkPolicy", func() {
+		Expect(newKVAPIServerProxyNetworkPolicy(hco).Spec.Egress[1].Ports).To(Equal([]networkingv1.NetworkPolicyPort{
+			{Port: ptr.To(intstr.FromInt32(apiServerPort)), Protocol: ptr.To(corev1.ProtocolTCP)},
+		}))
+
+		hco.Spec.NetworkPolicies = &hcov1beta1.NetworkPoliciesConfig{
+			APIServer: &hcov1beta1.NetworkPoliciesAPIServer{Ports: []int32{443}},
+		}
+		Expect(newKVAPIServerProxyNetworkPolicy(hco).Spec.Egress[1].Ports).To(Equal([]networkingv1.NetworkPolicyPort{
+			{Port: ptr.To(intstr.FromInt32(443)), Protocol: ptr.To(corev1.ProtocolTCP)},
+		}))
+	})
+
+	It("should allow the traffic from and to the Prometheus namespace", func() {
+		hco.Spec.NetworkPolicies = &hcov1beta1.NetworkPoliciesConfig{PrometheusNamespace: "custom-monitoring"}
+		cl := commontestutils.InitClient([]client.Object{hco})
+		ensureAll(GetNetworkPolicyHandlers(cl, commontestutils.GetScheme()))
+
+		np, err := getNP(cl, prometheusNamespaceNetworkPolicyName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(np.Spec.Ingress).To(HaveLen(1))
+		Expect(np.Spec.Ingress[0].From[0].NamespaceSelector.MatchLabels).To(HaveKeyWithValue(hcoutil.KubernetesMetadataName, "custom-monitoring"))
+		Expect(np.Spec.Egress).To(HaveLen(1))
+		Expect(np.Spec.Egress[0].To[0].NamespaceSelector.MatchLabels).To(HaveKeyWithValue(hcoutil.KubernetesMetadataName, "custom-monitoring"))
+	})
+
+	It("should update and remove the NetworkPolicies when the configuration changes", func() {
+		hco.Spec.NetworkPolicies = &hcov1beta1.NetworkPoliciesConfig{PrometheusNamespace: "custom-monitoring"}
+		cl := commontestutils.InitClient([]client.Object{hco, newPrometheusNamespaceNetworkPolicy(hco)})
+		handlers := GetNetworkPolicyHandlers(cl, commontestutils.GetScheme())
+
+		hco.Spec.NetworkPolicies.PrometheusNamespace = "other-monitoring"
+		ensureAll(handlers)
+
+		np, err := getNP(cl, prometheusNamespaceNetworkPolicyName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(np.Spec.Ingress[0].From[0].NamespaceSelector.MatchLabels).To(HaveKeyWithValue(hcoutil.KubernetesMetadataName, "other-monitoring"))
+
+		hco.Spec.NetworkPolicies = nil
+		ensureAll(handlers)
+
+		_, err = getNP(cl, prometheusNamespaceNetworkPolicyName)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,176 @@
+package networkbinding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	netattdefv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+// Plugin is a managed network binding plugin: the bundle of the components that HCO deploys for a network binding
+// plugin, and the binding that HCO adds to the KubeVirt CR. All the components are optional; a plugin only deploys
+// the components that are set.
+type Plugin struct {
+	// Name is the name of the plugin in the HyperConverged spec.networkBindingPlugins field
+	Name hcov1beta1.NetworkBindingPluginName
+	// BindingName is the name of the binding in the KubeVirt CR, to be used in the virtual machine interfaces
+	BindingName string
+	// Binding returns the KubeVirt network binding plugin configuration
+	Binding func() kubevirtcorev1.InterfaceBindingPlugin
+	// KubeVirtFeatureGates are the KubeVirt feature gates that the plugin requires
+	KubeVirtFeatureGates []string
+	// LegacyAnnotation is a deprecated HyperConverged annotation that also enables the plugin, when set to "true"
+	LegacyAnnotation string
+
+	// ServiceAccount is the ServiceAccount of the CNI plugin DaemonSet. It is only deployed on OpenShift.
+	ServiceAccount func(*hcov1beta1.HyperConverged) *corev1.ServiceAccount
+	// SecurityContextConstraints allows the CNI plugin DaemonSet to run. It is only deployed on OpenShift.
+	SecurityContextConstraints func(*hcov1beta1.HyperConverged) *securityv1.SecurityContextConstraints
+	// DaemonSet installs the CNI plugin on the nodes
+	DaemonSet func(*hcov1beta1.HyperConverged) *appsv1.DaemonSet
+	// NetworkAttachmentDefinition is the NetworkAttachmentDefinition of the CNI plugin
+	NetworkAttachmentDefinition func(*hcov1beta1.HyperConverged) *netattdefv1.NetworkAttachmentDefinition
+}
+
+// IsEnabled returns true if the plugin is in the spec.networkBindingPlugins list, or if its legacy annotation is set
+func (p *Plugin) IsEnabled(hc *hcov1beta1.HyperConverged) bool {
+	if p.LegacyAnnotation != "" && hc.Annotations[p.LegacyAnnotation] == "true" {
+		return true
+	}
+
+	return slices.ContainsFunc(hc.Spec.NetworkBindingPlugins, func(plugin hcov1beta1.NetworkBindingPlugin) bool {
+		return plugin.Name == p.Name
+	})
+}
+
+// GetHandlers returns the handlers of the components of the plugin. Each handler deploys its component if the plugin
+// is enabled, and removes it otherwise.
+func (p *Plugin) GetHandlers(cli client.Client, scheme *runtime.Scheme, isOpenshift bool) []operands.Operand {
+	var handlers []operands.Operand
+
+	if p.DaemonSet != nil {
+		handlers = append(handlers, p.NewDaemonSetHandler(cli, scheme))
+	}
+
+	if p.NetworkAttachmentDefinition != nil {
+		handlers = append(handlers, p.NewNetworkAttachmentDefinitionHandler(cli, scheme))
+	}
+
+	if isOpenshift {
+		if p.ServiceAccount != nil {
+			handlers = append(handlers, p.NewServiceAccountHandler(cli, scheme))
+		}
+
+		if p.SecurityContextConstraints != nil {
+			handlers = append(handlers, p.NewSecurityContextConstraintsHandler(cli, scheme))
+		}
+	}
+
+	return handlers
+}
+
+// NewServiceAccountHandler returns the handler of the plugin ServiceAccount
+func (p *Plugin) NewServiceAccountHandler(cli client.Client, scheme *runtime.Scheme) operands.Operand {
+	return p.newConditionalHandler(
+		operands.NewServiceAccountHandler(cli, scheme, p.ServiceAccount),
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return p.ServiceAccount(hc)
+		},
+	)
+}
+
+// NewSecurityContextConstraintsHandler returns the handler of the plugin SecurityContextConstraints
+func (p *Plugin) NewSecurityContextConstraintsHandler(cli client.Client, scheme *runtime.Scheme) operands.Operand {
+	return p.newConditionalHandler(
+		operands.NewSecurityContextConstraintsHandler(cli, scheme, p.SecurityContextConstraints),
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return p.SecurityContextConstraints(hc)
+		},
+	)
+}
+
+// NewDaemonSetHandler returns the handler of the plugin DaemonSet
+func (p *Plugin) NewDaemonSetHandler(cli client.Client, scheme *runtime.Scheme) operands.Operand {
+	return p.newConditionalHandler(
+		operands.NewDaemonSetHandler(cli, scheme, p.DaemonSet),
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return p.DaemonSet(hc)
+		},
+	)
+}
+
+// NewNetworkAttachmentDefinitionHandler returns the handler of the plugin NetworkAttachmentDefinition
+func (p *Plugin) NewNetworkAttachmentDefinitionHandler(cli client.Client, scheme *runtime.Scheme) operands.Operand {
+	return p.newConditionalHandler(
+		operands.NewNetworkAttachmentDefinitionHandler(cli, scheme, p.NetworkAttachmentDefinition),
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return p.NetworkAttachmentDefinition(hc)
+		},
+	)
+}
+
+func (p *Plugin) newConditionalHandler(handler *operands.GenericOperand, objectCreator func(hc *hcov1beta1.HyperConverged) client.Object) operands.Operand {
+	return operands.NewConditionalHandler(handler, p.IsEnabled, objectCreator)
+}
+
+// GetObjectsToDelete returns the components of the plugin that are not removed by the garbage collector when the
+// HyperConverged CR is deleted, because they are cluster scoped, or in another namespace.
+func (p *Plugin) GetObjectsToDelete(hc *hcov1beta1.HyperConverged) []client.Object {
+	var objects []client.Object
+
+	if p.NetworkAttachmentDefinition != nil {
+		objects = append(objects, p.NetworkAttachmentDefinition(hc))
+	}
+
+	if p.SecurityContextConstraints != nil {
+		objects = append(objects, p.SecurityContextConstraints(hc))
+	}
+
+	return objects
+}
+
+// GetStatus returns the state of the plugin. The plugin is ready when its CNI plugin DaemonSet, if any, is ready on
+// all the nodes.
+func (p *Plugin) GetStatus(ctx context.Context, cli client.Reader, hc *hcov1beta1.HyperConverged) hcov1beta1.NetworkBindingPluginStatus {
+	status := hcov1beta1.NetworkBindingPluginStatus{
+		Name:        p.Name,
+		BindingName: p.BindingName,
+		Ready:       true,
+	}
+
+	if p.DaemonSet == nil {
+		return status
+	}
+
+	ds := p.DaemonSet(hc)
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(ds), ds); err != nil {
+		status.Ready = false
+		if apierrors.IsNotFound(err) {
+			status.Message = fmt.Sprintf("the %s DaemonSet was not created yet", ds.Name)
+		} else {
+			status.Message = fmt.Sprintf("failed to read the %s DaemonSet: %v", ds.Name, err)
+		}
+		return status
+	}
+
+	if ds.Status.ObservedGeneration < ds.Generation ||
+		ds.Status.UpdatedNumberScheduled < ds.Status.DesiredNumberScheduled ||
+		ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
+		status.Ready = false
+		status.Message = fmt.Sprintf("the %s DaemonSet is not ready; %d of %d pods are ready", ds.Name, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled)
+	}
+
+	return status
+}
@@ -0,0 +1,13 @@
+package networkbinding_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestNetworkBinding(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Network Binding Plugins Suite")
+}
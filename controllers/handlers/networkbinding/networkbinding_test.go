@@ -0,0 +1,185 @@
+package networkbinding_test
+
+import (
+	"context"
+
+	netattdefv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	securityv1 "github.com/openshift/api/security/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/networkbinding"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	testPluginName       hcov1beta1.NetworkBindingPluginName = "test"
+	testPluginAnnotation                                     = "hco.kubevirt.io/deployTestBinding"
+	testPluginObjectName                                     = "test-binding-cni"
+)
+
+func testLabels() map[string]string {
+	return hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentNetwork)
+}
+
+func newTestPlugin() *networkbinding.Plugin {
+	return &networkbinding.Plugin{
+		Name:        testPluginName,
+		BindingName: "test",
+		Binding: func() kubevirtcorev1.InterfaceBindingPlugin {
+			return kubevirtcorev1.InterfaceBindingPlugin{DomainAttachmentType: kubevirtcorev1.Tap}
+		},
+		LegacyAnnotation: testPluginAnnotation,
+		ServiceAccount: func(hc *hcov1beta1.HyperConverged) *corev1.ServiceAccount {
+			return &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: testPluginObjectName, Namespace: hc.Namespace, Labels: testLabels()},
+			}
+		},
+		SecurityContextConstraints: func(_ *hcov1beta1.HyperConverged) *securityv1.SecurityContextConstraints {
+			return &securityv1.SecurityContextConstraints{
+				ObjectMeta: metav1.ObjectMeta{Name: testPluginObjectName, Labels: testLabels()},
+			}
+		},
+		DaemonSet: func(hc *hcov1beta1.HyperConverged) *appsv1.DaemonSet {
+			return &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: testPluginObjectName, Namespace: hc.Namespace, Labels: testLabels()},
+				Spec: appsv1.DaemonSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": testPluginObjectName}},
+				},
+			}
+		},
+		NetworkAttachmentDefinition: func(_ *hcov1beta1.HyperConverged) *netattdefv1.NetworkAttachmentDefinition {
+			return &netattdefv1.NetworkAttachmentDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: testPluginObjectName, Namespace: "default", Labels: testLabels()},
+				Spec:       netattdefv1.NetworkAttachmentDefinitionSpec{Config: `{"cniVersion": "1.0.0"}`},
+			}
+		},
+	}
+}
+
+var _ = Describe("Managed network binding plugin", func() {
+	var (
+		hco    *hcov1beta1.HyperConverged
+		plugin *networkbinding.Plugin
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		plugin = newTestPlugin()
+	})
+
+	Context("IsEnabled", func() {
+		It("should not be enabled by default", func() {
+			Expect(plugin.IsEnabled(hco)).To(BeFalse())
+		})
+
+		It("should be enabled if it is in spec.networkBindingPlugins", func() {
+			hco.Spec.NetworkBindingPlugins = []hcov1beta1.NetworkBindingPlugin{{Name: testPluginName}}
+			Expect(plugin.IsEnabled(hco)).To(BeTrue())
+		})
+
+		It("should be enabled by the legacy annotation", func() {
+			hco.Annotations = map[string]string{testPluginAnnotation: "true"}
+			Expect(plugin.IsEnabled(hco)).To(BeTrue())
+
+			hco.Annotations[testPluginAnnotation] = "false"
+			Expect(plugin.IsEnabled(hco)).To(BeFalse())
+		})
+	})
+
+	Context("GetHandlers", func() {
+		It("should only deploy the ServiceAccount and the SCC on OpenShift", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			Expect(plugin.GetHandlers(cl, commontestutils.GetScheme(), true)).To(HaveLen(4))
+			Expect(plugin.GetHandlers(cl, commontestutils.GetScheme(), false)).To(HaveLen(2))
+		})
+
+		It("should not return handlers for the components that the plugin does not have", func() {
+			plugin.DaemonSet = nil
+			plugin.NetworkAttachmentDefinition = nil
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			Expect(plugin.GetHandlers(cl, commontestutils.GetScheme(), false)).To(BeEmpty())
+		})
+
+		It("should deploy the components when the plugin is enabled, and remove them when it is disabled", func() {
+			hco.Spec.NetworkBindingPlugins = []hcov1beta1.NetworkBindingPlugin{{Name: testPluginName}}
+			req := commontestutils.NewReq(hco)
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			handlers := plugin.GetHandlers(cl, commontestutils.GetScheme(), true)
+			for _, handler := range handlers {
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Created).To(BeTrue())
+			}
+
+			ds := &appsv1.DaemonSet{}
+			Expect(cl.Get(context.TODO(), client.ObjectKey{Name: testPluginObjectName, Namespace: hco.Namespace}, ds)).To(Succeed())
+
+			hco.Spec.NetworkBindingPlugins = nil
+			for _, handler := range handlers {
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Deleted).To(BeTrue())
+			}
+
+			err := cl.Get(context.TODO(), client.ObjectKey{Name: testPluginObjectName, Namespace: hco.Namespace}, ds)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	It("should only return the cluster scoped and the other namespace components to delete", func() {
+		objects := plugin.GetObjectsToDelete(hco)
+		Expect(objects).To(HaveLen(2))
+		Expect(objects[0]).To(BeAssignableToTypeOf(&netattdefv1.NetworkAttachmentDefinition{}))
+		Expect(objects[1]).To(BeAssignableToTypeOf(&securityv1.SecurityContextConstraints{}))
+	})
+
+	Context("GetStatus", func() {
+		It("should be ready if the plugin has no DaemonSet", func() {
+			plugin.DaemonSet = nil
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			status := plugin.GetStatus(context.TODO(), cl, hco)
+			Expect(status.Ready).To(BeTrue())
+			Expect(status.Message).To(BeEmpty())
+			Expect(status.BindingName).To(Equal("test"))
+		})
+
+		It("should not be ready if the DaemonSet does not exist", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			status := plugin.GetStatus(context.TODO(), cl, hco)
+			Expect(status.Ready).To(BeFalse())
+			Expect(status.Message).To(Equal("the test-binding-cni DaemonSet was not created yet"))
+		})
+
+		DescribeTable("should report the DaemonSet readiness", func(dsStatus appsv1.DaemonSetStatus, ready bool) {
+			ds := plugin.DaemonSet(hco)
+			ds.Status = dsStatus
+			cl := commontestutils.InitClient([]client.Object{hco, ds})
+
+			status := plugin.GetStatus(context.TODO(), cl, hco)
+			Expect(status.Ready).To(Equal(ready))
+			if ready {
+				Expect(status.Message).To(BeEmpty())
+			} else {
+				Expect(status.Message).To(ContainSubstring("the test-binding-cni DaemonSet is not ready"))
+			}
+		},
+			Entry("all the pods are ready", appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberReady: 2}, true),
+			Entry("some pods are not ready", appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberReady: 1}, false),
+			Entry("some pods are not updated", appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, UpdatedNumberScheduled: 1, NumberReady: 2}, false),
+		)
+	})
+})
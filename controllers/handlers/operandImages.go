@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"os"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// operandImageEnvVars maps the components that use the images that HCO deploys, or passes to the operands, to the
+// environment variables of the HyperConverged Cluster Operator deployment that hold these images
+var operandImageEnvVars = []struct {
+	name   string
+	envVar string
+}{
+	{name: hcoutil.HCOOperatorName, envVar: hcoutil.OperatorImageEnvV},
+	{name: string(hcoutil.AppComponentUIPlugin), envVar: hcoutil.KVUIPluginImageEnvV},
+	{name: string(hcoutil.AppComponentUIProxy), envVar: hcoutil.KVUIProxyImageEnvV},
+	{name: "passt-binding-sidecar", envVar: hcoutil.PasstImageEnvV},
+	{name: "passt-binding-cni", envVar: hcoutil.PasstCNIImageEnvV},
+	{name: "wasp-agent", envVar: hcoutil.WaspAgentImageEnvV},
+	{name: virtioWinCmName, envVar: hcoutil.VirtioWinImageEnvV},
+}
+
+// GetOperandImages returns the images that HCO deploys, or passes to the operands, without their digests. Images
+// that are not configured are skipped.
+func GetOperandImages() []hcov1beta1.OperandImage {
+	var images []hcov1beta1.OperandImage
+	for _, img := range operandImageEnvVars {
+		if image := os.Getenv(img.envVar); image != "" {
+			images = append(images, hcov1beta1.OperandImage{Name: img.name, Image: image})
+		}
+	}
+
+	return images
+}
+
+// operatorDeployments lists the deployments of the operators of the operands, that are deployed along with HCO, and
+// the names of their operator containers
+var operatorDeployments = []struct {
+	name      string
+	container string
+}{
+	{name: "virt-operator", container: "virt-operator"},
+	{name: "cdi-operator", container: "cdi-operator"},
+	{name: "cluster-network-addons-operator", container: "cluster-network-addons-operator"},
+	{name: hcoutil.SSPOperatorName, container: "manager"},
+	{name: "aaq-operator", container: "aaq-operator"},
+	{name: "kubevirt-migration-operator", container: "operator"},
+}
+
+// GetOperatorImages returns the images of the operators of the operands, as set in their deployments, without their
+// digests. HCO does not manage these deployments, but they are deployed along with it (e.g. by OLM), and they are not
+// in the cache, so the reader should be the uncached API reader. Missing deployments are skipped.
+func GetOperatorImages(req *common.HcoRequest, reader client.Reader) ([]hcov1beta1.OperandImage, error) {
+	deployments := &appsv1.DeploymentList{}
+	err := reader.List(req.Ctx, deployments,
+		client.InNamespace(req.Namespace),
+		client.MatchingLabels{hcoutil.AppLabelPartOf: hcoutil.HyperConvergedCluster},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	containerImages := make(map[string]string, len(operatorDeployments))
+	for _, deployment := range deployments.Items {
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			containerImages[deployment.Name+"/"+container.Name] = container.Image
+		}
+	}
+
+	var images []hcov1beta1.OperandImage
+	for _, op := range operatorDeployments {
+		if image := containerImages[op.name+"/"+op.container]; image != "" {
+			images = append(images, hcov1beta1.OperandImage{Name: op.name, Image: image})
+		}
+	}
+
+	return images, nil
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Operand images", func() {
+	It("should return only the configured images", func() {
+		for _, img := range operandImageEnvVars {
+			GinkgoT().Setenv(img.envVar, "")
+		}
+		Expect(GetOperandImages()).To(BeEmpty())
+
+		GinkgoT().Setenv(hcoutil.OperatorImageEnvV, "quay.io/kubevirt/hyperconverged-cluster-operator:latest")
+		GinkgoT().Setenv(hcoutil.VirtioWinImageEnvV, "quay.io/kubevirt/virtio-container-disk@sha256:1234")
+
+		Expect(GetOperandImages()).To(Equal([]hcov1beta1.OperandImage{
+			{Name: hcoutil.HCOOperatorName, Image: "quay.io/kubevirt/hyperconverged-cluster-operator:latest"},
+			{Name: virtioWinCmName, Image: "quay.io/kubevirt/virtio-container-disk@sha256:1234"},
+		}))
+	})
+
+	It("should pass the image pull secrets to KubeVirt and CDI", func() {
+		hco := commontestutils.NewHco()
+
+		kv, err := NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Spec.ImagePullSecrets).To(BeNil())
+
+		cdi, err := NewCDI(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cdi.Spec.Config.ImagePullSecrets).To(BeNil())
+
+		hco.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "registry-secret"}}
+
+		kv, err = NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Spec.ImagePullSecrets).To(Equal(hco.Spec.ImagePullSecrets))
+
+		cdi, err = NewCDI(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cdi.Spec.Config.ImagePullSecrets).To(Equal(hco.Spec.ImagePullSecrets))
+	})
+})
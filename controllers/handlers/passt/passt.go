@@ -19,16 +19,23 @@ import (
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/networkbinding"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
 const (
+	// DeployPasstNetworkBindingAnnotation enables the passt network binding plugin. It is kept for backward
+	// compatibility; add passt to spec.networkBindingPlugins instead.
 	DeployPasstNetworkBindingAnnotation = hcoutil.HCOAnnotationPrefix + "deployPasstNetworkBinding"
 
 	BindingName = "passt"
 
+	// IPStackMigrationFeatureGate is the KubeVirt feature gate that allows migrating virtual machines with the passt
+	// binding
+	IPStackMigrationFeatureGate = "PasstIPStackMigration"
+
 	passtCNIObjectName = "passt-binding-cni"
 
 	networkBindingNADName       = "primary-udn-kubevirt-binding"
@@ -257,54 +264,35 @@ func NewPasstBindingCNISecurityContextConstraints(hc *hcov1beta1.HyperConverged)
 	}
 }
 
+// Plugin is the managed passt network binding plugin
+var Plugin = &networkbinding.Plugin{
+	Name:                        hcov1beta1.NetworkBindingPluginPasst,
+	BindingName:                 BindingName,
+	Binding:                     NetworkBinding,
+	KubeVirtFeatureGates:        []string{IPStackMigrationFeatureGate},
+	LegacyAnnotation:            DeployPasstNetworkBindingAnnotation,
+	ServiceAccount:              NewPasstBindingCNISA,
+	SecurityContextConstraints:  NewPasstBindingCNISecurityContextConstraints,
+	DaemonSet:                   NewPasstBindingCNIDaemonSet,
+	NetworkAttachmentDefinition: NewPasstBindingCNINetworkAttachmentDefinition,
+}
+
 // NewPasstServiceAccountHandler creates a conditional handler for passt ServiceAccount
 func NewPasstServiceAccountHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
-	return createPasstConditionalHandler(
-		operands.NewServiceAccountHandler(Client, Scheme, NewPasstBindingCNISA),
-		func(hc *hcov1beta1.HyperConverged) client.Object {
-			return NewPasstBindingCNISA(hc)
-		},
-	)
+	return Plugin.NewServiceAccountHandler(Client, Scheme)
 }
 
 // NewPasstDaemonSetHandler creates a conditional handler for passt DaemonSet
 func NewPasstDaemonSetHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
-	return createPasstConditionalHandler(
-		operands.NewDaemonSetHandler(Client, Scheme, NewPasstBindingCNIDaemonSet),
-		func(hc *hcov1beta1.HyperConverged) client.Object {
-			return NewPasstBindingCNIDaemonSetWithNameOnly(hc)
-		},
-	)
+	return Plugin.NewDaemonSetHandler(Client, Scheme)
 }
 
 // NewPasstNetworkAttachmentDefinitionHandler creates a conditional handler for passt NetworkAttachmentDefinition
 func NewPasstNetworkAttachmentDefinitionHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
-	return createPasstConditionalHandler(
-		operands.NewNetworkAttachmentDefinitionHandler(Client, Scheme, NewPasstBindingCNINetworkAttachmentDefinition),
-		func(hc *hcov1beta1.HyperConverged) client.Object {
-			return NewPasstBindingCNINetworkAttachmentDefinition(hc)
-		},
-	)
+	return Plugin.NewNetworkAttachmentDefinitionHandler(Client, Scheme)
 }
 
 // NewPasstSecurityContextConstraintsHandler creates a conditional handler for passt SecurityContextConstraints
 func NewPasstSecurityContextConstraintsHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
-	return createPasstConditionalHandler(
-		operands.NewSecurityContextConstraintsHandler(Client, Scheme, NewPasstBindingCNISecurityContextConstraints),
-		func(hc *hcov1beta1.HyperConverged) client.Object {
-			return NewPasstBindingCNISecurityContextConstraints(hc)
-		},
-	)
-}
-
-// createPasstConditionalHandler creates a conditional handler that checks for passt deployment annotation
-func createPasstConditionalHandler(handler *operands.GenericOperand, objectCreator func(hc *hcov1beta1.HyperConverged) client.Object) operands.Operand {
-	return operands.NewConditionalHandler(
-		handler,
-		func(hc *hcov1beta1.HyperConverged) bool {
-			value, ok := hc.Annotations[DeployPasstNetworkBindingAnnotation]
-			return ok && value == "true"
-		},
-		objectCreator,
-	)
+	return Plugin.NewSecurityContextConstraintsHandler(Client, Scheme)
 }
@@ -33,13 +33,14 @@ const (
 type sspHandler struct {
 	handler *operands.GenericOperand
 	hook    *sspHooks
+	client  client.Client
 }
 
 func (h *sspHandler) Ensure(req *common.HcoRequest) *operands.EnsureResult {
 	res := h.handler.Ensure(req)
 
 	if res.Err == nil {
-		h.hook.updateDICTsInHCStatus(req)
+		h.hook.updateDICTsInHCStatus(req, h.client)
 	}
 
 	return res
@@ -56,6 +57,7 @@ func NewSspHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operan
 	return &sspHandler{
 		handler: handler,
 		hook:    hook,
+		client:  Client,
 	}
 }
 
@@ -121,13 +123,40 @@ func (*sspHooks) UpdateCR(req *common.HcoRequest, client client.Client, exists r
 	return false, false, nil
 }
 
-func (h *sspHooks) updateDICTsInHCStatus(req *common.HcoRequest) {
-	if !reflect.DeepEqual(h.dictStatuses, req.Instance.Status.DataImportCronTemplates) {
-		req.Instance.Status.DataImportCronTemplates = h.dictStatuses
+func (h *sspHooks) updateDICTsInHCStatus(req *common.HcoRequest, cl client.Reader) {
+	dictStatuses := h.getDictStatuses()
+
+	err := goldenimages.SetDataImportCronTemplatesImportStatus(req.Ctx, cl, dictStatuses, req.Instance.Status.DataImportCronTemplates)
+	if err != nil {
+		// the import status is informative; don't fail the reconciliation because of it
+		req.Logger.Error(err, "failed to read the import status of the DataImportCronTemplates")
+		dictStatuses = h.getDictStatuses()
+	}
+
+	if !reflect.DeepEqual(dictStatuses, req.Instance.Status.DataImportCronTemplates) {
+		req.Instance.Status.DataImportCronTemplates = dictStatuses
 		req.StatusDirty = true
 	}
 
 	goldenimages.CheckDataImportCronTemplates(req.Instance)
+	goldenimages.SetDataImportCronTemplatesImportMetrics(req.Instance.Status.DataImportCronTemplates)
+}
+
+// getDictStatuses returns a copy of the cached DataImportCronTemplate statuses, to be modified by the caller
+func (h *sspHooks) getDictStatuses() []hcov1beta1.DataImportCronTemplateStatus {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.dictStatuses == nil {
+		return nil
+	}
+
+	dictStatuses := make([]hcov1beta1.DataImportCronTemplateStatus, len(h.dictStatuses))
+	for i := range h.dictStatuses {
+		h.dictStatuses[i].DeepCopyInto(&dictStatuses[i])
+	}
+
+	return dictStatuses
 }
 
 func NewSSP(hc *hcov1beta1.HyperConverged) (*sspv1beta3.SSP, []hcov1beta1.DataImportCronTemplateStatus, error) {
@@ -1236,6 +1236,62 @@ var _ = Describe("SSP Operands", func() {
 			})
 		})
 
+		Context("DataImportCronTemplates - import status", func() {
+			BeforeEach(func() {
+				origFuncGetDataImportCronTemplates := goldenimages.GetDataImportCronTemplates
+
+				DeferCleanup(func() {
+					goldenimages.GetDataImportCronTemplates = origFuncGetDataImportCronTemplates
+				})
+
+				goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+					return []hcov1beta1.DataImportCronTemplateStatus{makeDICT(1)}, nil
+				}
+			})
+
+			It("should fold the DataImportCron state into the HyperConverged status", func(ctx context.Context) {
+				lastImport := metav1.Now().Rfc3339Copy()
+				dic := &cdiv1beta1.DataImportCron{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "image1",
+						Namespace: "kubevirt-os-images",
+					},
+					Status: cdiv1beta1.DataImportCronStatus{
+						LastImportTimestamp: &lastImport,
+						Conditions: []cdiv1beta1.DataImportCronCondition{
+							{
+								Type: cdiv1beta1.DataImportCronUpToDate,
+								ConditionState: cdiv1beta1.ConditionState{
+									Status:             corev1.ConditionTrue,
+									Reason:             "UpToDate",
+									LastTransitionTime: lastImport,
+								},
+							},
+						},
+					},
+				}
+
+				cli := commontestutils.InitClient([]client.Object{hco, dic})
+				handler := NewSspHandler(cli, commontestutils.GetScheme())
+
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				Expect(hco.Status.DataImportCronTemplates).To(HaveLen(1))
+				dictStatus := hco.Status.DataImportCronTemplates[0].Status
+				Expect(dictStatus.LastImportTimestamp).ToNot(BeNil())
+				Expect(dictStatus.LastImportTimestamp.Time).To(BeTemporally("==", lastImport.Time))
+				Expect(meta.IsStatusConditionTrue(dictStatus.Conditions, goldenimages.DictConditionUpToDateType)).To(BeTrue())
+				Expect(metrics.IsDICTUpToDate("image1", "image1")).To(BeTrue())
+
+				By("should not change the status if nothing was changed")
+				prevDictStatuses := hco.Status.DeepCopy().DataImportCronTemplates
+				res = handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(hco.Status.DataImportCronTemplates).To(Equal(prevDictStatuses))
+			})
+		})
+
 		Context("TLSSecurityProfile", func() {
 
 			intermediateTLSSecurityProfile := &openshiftconfigv1.TLSSecurityProfile{
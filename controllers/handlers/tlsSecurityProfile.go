@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	tlsComponentHCO           = "hyperconverged-cluster-operator"
+	tlsComponentKubeVirt      = "kubevirt"
+	tlsComponentCDI           = "cdi"
+	tlsComponentCNAO          = "cluster-network-addons"
+	tlsComponentSSP           = "ssp"
+	tlsComponentConsolePlugin = "kubevirt-console-plugin"
+)
+
+// GetComponentsTLSSecurityProfiles returns the effective TLS security profile of each of the components that HCO
+// propagates the TLS security profile to.
+func GetComponentsTLSSecurityProfiles(hc *hcov1beta1.HyperConverged) []hcov1beta1.ComponentTLSSecurityProfile {
+	ci := hcoutil.GetClusterInfo()
+	profile := ci.GetTLSSecurityProfile(hc.Spec.TLSSecurityProfile)
+	profileSpec := hcoutil.GetTLSProfileSpec(profile)
+
+	profileType := profile.Type
+	if _, predefined := openshiftconfigv1.TLSProfiles[profileType]; !predefined && profile.Custom == nil {
+		profileType = openshiftconfigv1.TLSProfileIntermediateType
+	}
+
+	components := []string{tlsComponentHCO, tlsComponentKubeVirt, tlsComponentCDI, tlsComponentCNAO}
+	if ci.IsOpenshift() {
+		components = append(components, tlsComponentSSP)
+		if ci.IsConsolePluginImageProvided() {
+			components = append(components, tlsComponentConsolePlugin)
+		}
+	}
+
+	profiles := make([]hcov1beta1.ComponentTLSSecurityProfile, 0, len(components))
+	for _, component := range components {
+		componentProfile := hcov1beta1.ComponentTLSSecurityProfile{
+			Component:     component,
+			Type:          profileType,
+			MinTLSVersion: profileSpec.MinTLSVersion,
+			Ciphers:       profileSpec.Ciphers,
+		}
+
+		if component == tlsComponentKubeVirt {
+			// KubeVirt falls back to TLS 1.2 for unknown versions
+			kvConfig := hcTLSSecurityProfileToKv(profile)
+			componentProfile.MinTLSVersion = openshiftconfigv1.TLSProtocolVersion(kvConfig.MinTLSVersion)
+		}
+
+		profiles = append(profiles, componentProfile)
+	}
+
+	return profiles
+}
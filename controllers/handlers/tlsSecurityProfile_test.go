@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Components TLS security profiles", func() {
+	var hco *hcov1beta1.HyperConverged
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+	})
+
+	It("should report the intermediate profile by default", func() {
+		profiles := GetComponentsTLSSecurityProfiles(hco)
+		Expect(profiles).ToNot(BeEmpty())
+
+		for _, profile := range profiles {
+			Expect(profile.Type).To(Equal(openshiftconfigv1.TLSProfileIntermediateType))
+			Expect(profile.MinTLSVersion).To(Equal(openshiftconfigv1.VersionTLS12))
+			Expect(profile.Ciphers).ToNot(BeEmpty())
+		}
+	})
+
+	It("should report TLS 1.3 only for the modern profile", func() {
+		hco.Spec.TLSSecurityProfile = &openshiftconfigv1.TLSSecurityProfile{
+			Type: openshiftconfigv1.TLSProfileModernType,
+		}
+
+		profiles := GetComponentsTLSSecurityProfiles(hco)
+		Expect(profiles).To(ContainElement(hcov1beta1.ComponentTLSSecurityProfile{
+			Component:     tlsComponentKubeVirt,
+			Type:          openshiftconfigv1.TLSProfileModernType,
+			MinTLSVersion: openshiftconfigv1.VersionTLS13,
+		}))
+
+		for _, profile := range profiles {
+			Expect(profile.Type).To(Equal(openshiftconfigv1.TLSProfileModernType))
+			Expect(profile.MinTLSVersion).To(Equal(openshiftconfigv1.VersionTLS13))
+			Expect(profile.Ciphers).To(BeEmpty())
+		}
+	})
+})
@@ -38,7 +38,7 @@ func NewVirtioWinCmReaderRoleBindingHandler(_ log.Logger, Client client.Client,
 }
 
 func NewVirtioWinCm(hc *hcov1beta1.HyperConverged) (*corev1.ConfigMap, error) {
-	virtiowinContainer := os.Getenv("VIRTIOWIN_CONTAINER")
+	virtiowinContainer := os.Getenv(hcoutil.VirtioWinImageEnvV)
 	if virtiowinContainer == "" {
 		return nil, errors.New("kv-virtiowin-image-name was not specified")
 	}
@@ -130,5 +130,75 @@ var _ = Describe("Wasp Agent Service Account", func() {
 			}
 			Expect(foundDs.Labels).To(HaveKeyWithValue("user-added-label", "user-value"))
 		})
+
+		It("should reconcile the image pull secrets", func() {
+			hco.Spec.HigherWorkloadDensity = &hcov1beta1.HigherWorkloadDensityConfiguration{
+				MemoryOvercommitPercentage: 150,
+			}
+			sa := newWaspAgentServiceAccount(hco)
+			cl = commontestutils.InitClient([]client.Object{hco, sa})
+
+			hco.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "registry-secret"}}
+			handler := NewWaspAgentServiceAccountHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			foundSA := &corev1.ServiceAccount{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: "wasp", Namespace: hco.Namespace}, foundSA)).To(Succeed())
+			Expect(foundSA.ImagePullSecrets).To(Equal(hco.Spec.ImagePullSecrets))
+
+			hco.Spec.ImagePullSecrets = nil
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: "wasp", Namespace: hco.Namespace}, foundSA)).To(Succeed())
+			Expect(foundSA.ImagePullSecrets).To(BeEmpty())
+		})
+
+		It("should keep the image pull secrets that were not added by HCO", func() {
+			hco.Spec.HigherWorkloadDensity = &hcov1beta1.HigherWorkloadDensityConfiguration{
+				MemoryOvercommitPercentage: 150,
+			}
+			dockercfgSecret := corev1.LocalObjectReference{Name: "wasp-dockercfg-abcde"}
+			sa := newWaspAgentServiceAccount(hco)
+			sa.ImagePullSecrets = []corev1.LocalObjectReference{dockercfgSecret}
+			cl = commontestutils.InitClient([]client.Object{hco, sa})
+			handler := NewWaspAgentServiceAccountHandler(cl, commontestutils.GetScheme())
+
+			By("not touching the injected secret when spec.imagePullSecrets is empty")
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+
+			foundSA := &corev1.ServiceAccount{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: "wasp", Namespace: hco.Namespace}, foundSA)).To(Succeed())
+			Expect(foundSA.ImagePullSecrets).To(HaveExactElements(dockercfgSecret))
+
+			By("adding the HyperConverged image pull secrets")
+			hco.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "registry-secret"}}
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: "wasp", Namespace: hco.Namespace}, foundSA)).To(Succeed())
+			Expect(foundSA.ImagePullSecrets).To(HaveExactElements(dockercfgSecret, hco.Spec.ImagePullSecrets[0]))
+
+			By("not updating the ServiceAccount again")
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+
+			By("removing only the image pull secrets that HCO added")
+			hco.Spec.ImagePullSecrets = nil
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: "wasp", Namespace: hco.Namespace}, foundSA)).To(Succeed())
+			Expect(foundSA.ImagePullSecrets).To(HaveExactElements(dockercfgSecret))
+		})
 	})
 })
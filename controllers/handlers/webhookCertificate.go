@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// the Certificate, and the Secret it creates, for the webhook serving certificate, issued by the issuer from
+	// spec.certConfig.issuerRef
+	webhookIssuedCertName = hcoutil.HCOWebhookName + "-issued-cert"
+	// the Secret with the default webhook serving certificate, created by OLM or by cert-manager with the self-signed
+	// issuer from the deployment manifests
+	webhookDefaultCertName = hcoutil.HCOWebhookName + "-service-cert"
+	webhookServiceName     = hcoutil.HCOWebhookName + "-service"
+	webhookCertVolumeName  = "apiservice-cert"
+
+	hcoMutatingWebhookConfigName = "mutate-hco.kubevirt.io"
+
+	certManagerInjectCAAnnotation = "cert-manager.io/inject-ca-from"
+)
+
+const (
+	CertIssuerRefManagedByOLMReason            = "ManagedByOLM"
+	CertIssuerRefCertManagerNotAvailableReason = "CertManagerNotAvailable"
+)
+
+var certManagerCertificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// GetIgnoredCertIssuerRefReason returns the reason and the message why spec.certConfig.issuerRef can't be honored, so
+// the webhook keeps using its default certificate; or empty strings, if the issuerRef is not set or is honored.
+func GetIgnoredCertIssuerRefReason(hc *hcov1beta1.HyperConverged, ci hcoutil.ClusterInfo) (string, string) {
+	if hc.Spec.CertConfig.IssuerRef == nil {
+		return "", ""
+	}
+
+	if ci.IsManagedByOLM() {
+		return CertIssuerRefManagedByOLMReason, "spec.certConfig.issuerRef is ignored, because HCO is deployed by OLM, that manages the webhook certificate; the webhook keeps using the OLM certificate"
+	}
+
+	if !ci.IsCertManagerAvailable() {
+		return CertIssuerRefCertManagerNotAvailableReason, "spec.certConfig.issuerRef is ignored, because cert-manager is not installed in the cluster; the webhook keeps using the self-signed certificate"
+	}
+
+	return "", ""
+}
+
+// **** Handler for the cert-manager Certificate of the webhook ****
+
+// NewWebhookCertificateHandler creates the cert-manager Certificate for the webhook serving certificate, if
+// spec.certConfig.issuerRef is set, or deletes it otherwise. The cert-manager API is not vendored, so the Certificate
+// is handled as an unstructured object.
+func NewWebhookCertificateHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return operands.NewConditionalHandler(
+		operands.NewGenericOperand(Client, Scheme, "Certificate", &webhookCertificateHooks{}, false),
+		func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.CertConfig.IssuerRef != nil
+		},
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return newEmptyCertificate(webhookIssuedCertName, hc.Namespace)
+		},
+	)
+}
+
+type webhookCertificateHooks struct{}
+
+func (*webhookCertificateHooks) GetFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	return NewWebhookCertificate(hc), nil
+}
+
+func (*webhookCertificateHooks) GetEmptyCr() client.Object {
+	return newEmptyCertificate("", "")
+}
+
+func (*webhookCertificateHooks) UpdateCR(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	cert, ok1 := required.(*unstructured.Unstructured)
+	found, ok2 := exists.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		return false, false, errors.New("can't convert to Certificate")
+	}
+
+	if isCertificateSpecModified(found, cert) || !hcoutil.CompareLabels(cert, found) {
+		if req.HCOTriggered {
+			req.Logger.Info("Updating existing Certificate's Spec to new opinionated values")
+		} else {
+			req.Logger.Info("Reconciling an externally updated Certificate's Spec to its opinionated values")
+		}
+
+		labels := found.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		maps.Copy(labels, cert.GetLabels())
+		found.SetLabels(labels)
+
+		spec, _, _ := unstructured.NestedMap(found.Object, "spec")
+		if spec == nil {
+			spec = make(map[string]any)
+		}
+		maps.Copy(spec, cert.Object["spec"].(map[string]any))
+		found.Object["spec"] = spec
+
+		err := Client.Update(req.Ctx, found)
+		if err != nil {
+			return false, false, err
+		}
+		return true, !req.HCOTriggered, nil
+	}
+
+	return false, false, nil
+}
+
+// isCertificateSpecModified only compares the fields that HCO sets, as cert-manager may default other fields
+func isCertificateSpecModified(found, required *unstructured.Unstructured) bool {
+	foundSpec, _, _ := unstructured.NestedMap(found.Object, "spec")
+	for key, value := range required.Object["spec"].(map[string]any) {
+		if !reflect.DeepEqual(foundSpec[key], value) {
+			return true
+		}
+	}
+	return false
+}
+
+func newEmptyCertificate(name, namespace string) *unstructured.Unstructured {
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(certManagerCertificateGVK)
+	cert.SetName(name)
+	cert.SetNamespace(namespace)
+	return cert
+}
+
+// NewWebhookCertificate returns the cert-manager Certificate for the webhook serving certificate. The certificate uses
+// the server duration and renewBefore from spec.certConfig.
+func NewWebhookCertificate(hc *hcov1beta1.HyperConverged) *unstructured.Unstructured {
+	cert := newEmptyCertificate(webhookIssuedCertName, hc.Namespace)
+	labels := operands.GetLabels(hc, hcoutil.AppComponentDeployment)
+	cert.SetLabels(labels)
+
+	issuerRef := hc.Spec.CertConfig.IssuerRef
+	kind := issuerRef.Kind
+	if kind == "" {
+		kind = "Issuer"
+	}
+	group := issuerRef.Group
+	if group == "" {
+		group = certManagerCertificateGVK.Group
+	}
+
+	secretLabels := make(map[string]any, len(labels))
+	for k, v := range labels {
+		secretLabels[k] = v
+	}
+
+	spec := map[string]any{
+		"secretName": webhookIssuedCertName,
+		"secretTemplate": map[string]any{
+			"labels": secretLabels,
+		},
+		"dnsNames": []any{
+			webhookServiceName,
+			fmt.Sprintf("%s.%s", webhookServiceName, hc.Namespace),
+			fmt.Sprintf("%s.%s.svc", webhookServiceName, hc.Namespace),
+			fmt.Sprintf("%s.%s.svc.cluster.local", webhookServiceName, hc.Namespace),
+		},
+		"issuerRef": map[string]any{
+			"name":  issuerRef.Name,
+			"kind":  kind,
+			"group": group,
+		},
+	}
+
+	if duration := hc.Spec.CertConfig.Server.Duration; duration != nil {
+		spec["duration"] = duration.Duration.String()
+	}
+	if renewBefore := hc.Spec.CertConfig.Server.RenewBefore; renewBefore != nil {
+		spec["renewBefore"] = renewBefore.Duration.String()
+	}
+
+	cert.Object["spec"] = spec
+
+	return cert
+}
+
+// **** Handler for the webhook certificate wiring ****
+
+// NewWebhookCertificateWiringHandler mounts the Secret of the issued webhook certificate into the webhook Deployment,
+// once cert-manager created it, and points the cert-manager CA injection of the HCO webhook configurations to the
+// issued certificate. When spec.certConfig.issuerRef is removed, the default certificate is restored.
+//
+// The webhook Deployment and the webhook configurations are not in the cache, so they are read with apiReader.
+func NewWebhookCertificateWiringHandler(Client client.Client, apiReader client.Reader) operands.Operand {
+	return &webhookCertificateWiringHandler{
+		client:    Client,
+		apiReader: apiReader,
+	}
+}
+
+type webhookCertificateWiringHandler struct {
+	client    client.Client
+	apiReader client.Reader
+}
+
+func (h *webhookCertificateWiringHandler) Ensure(req *common.HcoRequest) *operands.EnsureResult {
+	res := operands.NewEnsureResult(&appsv1.Deployment{}).SetName(hcoutil.HCOWebhookName)
+
+	certName, err := h.getWebhookCertName(req)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	updated, err := h.ensureDeploymentSecret(req, certName)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	configsUpdated, err := h.ensureInjectCAAnnotation(req, certName)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	if updated || configsUpdated {
+		res.SetUpdated()
+	}
+
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (*webhookCertificateWiringHandler) Reset() { /* no implementation */ }
+
+// getWebhookCertName returns the name of the certificate that the webhook should use. The issued certificate is only
+// used after cert-manager created its Secret, so the webhook never mounts a missing Secret.
+func (h *webhookCertificateWiringHandler) getWebhookCertName(req *common.HcoRequest) (string, error) {
+	if req.Instance.Spec.CertConfig.IssuerRef == nil || !req.Instance.DeletionTimestamp.IsZero() {
+		return webhookDefaultCertName, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := h.apiReader.Get(req.Ctx, client.ObjectKey{Namespace: req.Namespace, Name: webhookIssuedCertName}, secret)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			req.Logger.Info("the issued webhook certificate is not ready yet; keep using the default certificate")
+			return webhookDefaultCertName, nil
+		}
+		return "", err
+	}
+
+	if len(secret.Data[corev1.TLSCertKey]) == 0 || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		req.Logger.Info("the issued webhook certificate is not ready yet; keep using the default certificate")
+		return webhookDefaultCertName, nil
+	}
+
+	return webhookIssuedCertName, nil
+}
+
+func (h *webhookCertificateWiringHandler) ensureDeploymentSecret(req *common.HcoRequest, certName string) (bool, error) {
+	deployment := &appsv1.Deployment{}
+	err := h.apiReader.Get(req.Ctx, client.ObjectKey{Namespace: req.Namespace, Name: hcoutil.HCOWebhookName}, deployment)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// e.g. when running locally
+			return false, nil
+		}
+		return false, err
+	}
+
+	for i, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name != webhookCertVolumeName || volume.Secret == nil {
+			continue
+		}
+
+		if volume.Secret.SecretName == certName {
+			return false, nil
+		}
+
+		req.Logger.Info("Updating the webhook certificate Secret", "deployment", deployment.Name, "secret", certName)
+		patch := client.MergeFrom(deployment.DeepCopy())
+		deployment.Spec.Template.Spec.Volumes[i].Secret.SecretName = certName
+		if err = h.client.Patch(req.Ctx, deployment, patch); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// ensureInjectCAAnnotation only modifies webhook configurations that already use the cert-manager CA injection, i.e.
+// when HCO is not deployed by OLM.
+func (h *webhookCertificateWiringHandler) ensureInjectCAAnnotation(req *common.HcoRequest, certName string) (bool, error) {
+	injectFrom := fmt.Sprintf("%s/%s", req.Namespace, certName)
+
+	configs := []client.Object{
+		&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: hcoutil.HcoValidatingWebhook}},
+		&admissionregistrationv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: hcoMutatingWebhookConfigName}},
+	}
+
+	updated := false
+	for _, config := range configs {
+		err := h.apiReader.Get(req.Ctx, client.ObjectKeyFromObject(config), config)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+
+		current, ok := config.GetAnnotations()[certManagerInjectCAAnnotation]
+		if !ok || current == injectFrom {
+			continue
+		}
+
+		req.Logger.Info("Updating the CA injection of the webhook configuration", "name", config.GetName(), "from", injectFrom)
+		patch := client.MergeFrom(config.DeepCopyObject().(client.Object))
+		annotations := config.GetAnnotations()
+		annotations[certManagerInjectCAAnnotation] = injectFrom
+		config.SetAnnotations(annotations)
+		if err = h.client.Patch(req.Ctx, config, patch); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Webhook certificate", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.CertConfig.IssuerRef = &hcov1beta1.CertManagerIssuerReference{
+			Name: "corporate-ca",
+			Kind: "ClusterIssuer",
+		}
+		req = commontestutils.NewReq(hco)
+	})
+
+	getCertificate := func(cli client.Client) (*unstructured.Unstructured, error) {
+		cert := newEmptyCertificate("", "")
+		err := cli.Get(context.TODO(), client.ObjectKey{Name: webhookIssuedCertName, Namespace: hco.Namespace}, cert)
+		return cert, err
+	}
+
+	Context("Certificate", func() {
+		It("should create the Certificate if issuerRef is set", func() {
+			cli := commontestutils.InitClient([]client.Object{hco})
+			handler := NewWebhookCertificateHandler(cli, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			cert, err := getCertificate(cli)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(nestedString(cert, "spec", "secretName")).To(Equal(webhookIssuedCertName))
+			Expect(nestedStringMap(cert, "spec", "issuerRef")).To(Equal(map[string]string{
+				"name":  "corporate-ca",
+				"kind":  "ClusterIssuer",
+				"group": "cert-manager.io",
+			}))
+			Expect(nestedStringSlice(cert, "spec", "dnsNames")).To(ContainElement(
+				"hyperconverged-cluster-webhook-service." + hco.Namespace + ".svc",
+			))
+			Expect(nestedString(cert, "spec", "duration")).To(Equal(hco.Spec.CertConfig.Server.Duration.Duration.String()))
+			Expect(nestedString(cert, "spec", "renewBefore")).To(Equal(hco.Spec.CertConfig.Server.RenewBefore.Duration.String()))
+		})
+
+		It("should use the default issuer kind and group", func() {
+			hco.Spec.CertConfig.IssuerRef = &hcov1beta1.CertManagerIssuerReference{Name: "ns-ca"}
+
+			cert := NewWebhookCertificate(hco)
+			Expect(nestedStringMap(cert, "spec", "issuerRef")).To(Equal(map[string]string{
+				"name":  "ns-ca",
+				"kind":  "Issuer",
+				"group": "cert-manager.io",
+			}))
+		})
+
+		It("should reconcile a modified Certificate, and keep the fields set by cert-manager", func() {
+			existing := NewWebhookCertificate(hco)
+			Expect(unstructured.SetNestedField(existing.Object, "another-ca", "spec", "issuerRef", "name")).To(Succeed())
+			Expect(unstructured.SetNestedField(existing.Object, "RSA", "spec", "privateKey", "algorithm")).To(Succeed())
+
+			cli := commontestutils.InitClient([]client.Object{hco, existing})
+			handler := NewWebhookCertificateHandler(cli, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			cert, err := getCertificate(cli)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nestedString(cert, "spec", "issuerRef", "name")).To(Equal("corporate-ca"))
+			Expect(nestedString(cert, "spec", "privateKey", "algorithm")).To(Equal("RSA"))
+		})
+
+		It("should update the Certificate durations when the server certConfig is modified", func() {
+			existing := NewWebhookCertificate(hco)
+			hco.Spec.CertConfig.Server.Duration = &metav1.Duration{Duration: 72 * time.Hour}
+
+			cli := commontestutils.InitClient([]client.Object{hco, existing})
+			handler := NewWebhookCertificateHandler(cli, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			cert, err := getCertificate(cli)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nestedString(cert, "spec", "duration")).To(Equal("72h0m0s"))
+		})
+
+		It("should delete the Certificate if issuerRef is removed", func() {
+			existing := NewWebhookCertificate(hco)
+			hco.Spec.CertConfig.IssuerRef = nil
+
+			cli := commontestutils.InitClient([]client.Object{hco, existing})
+			handler := NewWebhookCertificateHandler(cli, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+
+			_, err := getCertificate(cli)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("wiring", func() {
+		var (
+			deployment *appsv1.Deployment
+			validating *admissionregistrationv1.ValidatingWebhookConfiguration
+			mutating   *admissionregistrationv1.MutatingWebhookConfiguration
+		)
+
+		defaultInjectFrom := func() map[string]string {
+			return map[string]string{certManagerInjectCAAnnotation: hco.Namespace + "/" + webhookDefaultCertName}
+		}
+
+		BeforeEach(func() {
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: hcoutil.HCOWebhookName, Namespace: hco.Namespace},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: webhookCertVolumeName,
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{SecretName: webhookDefaultCertName},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			validating = &admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: hcoutil.HcoValidatingWebhook, Annotations: defaultInjectFrom()},
+			}
+			mutating = &admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: hcoMutatingWebhookConfigName, Annotations: defaultInjectFrom()},
+			}
+		})
+
+		issuedSecret := func() *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: webhookIssuedCertName, Namespace: hco.Namespace},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       []byte("cert"),
+					corev1.TLSPrivateKeyKey: []byte("key"),
+				},
+			}
+		}
+
+		expectWiredTo := func(cli client.Client, certName string) {
+			GinkgoHelper()
+			foundDeployment := &appsv1.Deployment{}
+			Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(deployment), foundDeployment)).To(Succeed())
+			Expect(foundDeployment.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal(certName))
+
+			injectFrom := hco.Namespace + "/" + certName
+			foundValidating := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+			Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(validating), foundValidating)).To(Succeed())
+			Expect(foundValidating.Annotations).To(HaveKeyWithValue(certManagerInjectCAAnnotation, injectFrom))
+
+			foundMutating := &admissionregistrationv1.MutatingWebhookConfiguration{}
+			Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(mutating), foundMutating)).To(Succeed())
+			Expect(foundMutating.Annotations).To(HaveKeyWithValue(certManagerInjectCAAnnotation, injectFrom))
+		}
+
+		It("should keep the default certificate until the issued Secret is ready", func() {
+			cli := commontestutils.InitClient([]client.Object{hco, deployment, validating, mutating})
+			handler := NewWebhookCertificateWiringHandler(cli, cli)
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+
+			expectWiredTo(cli, webhookDefaultCertName)
+		})
+
+		It("should use the issued certificate once the Secret is ready", func() {
+			cli := commontestutils.InitClient([]client.Object{hco, deployment, validating, mutating, issuedSecret()})
+			handler := NewWebhookCertificateWiringHandler(cli, cli)
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			expectWiredTo(cli, webhookIssuedCertName)
+		})
+
+		It("should restore the default certificate when issuerRef is removed", func() {
+			deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName = webhookIssuedCertName
+			validating.Annotations[certManagerInjectCAAnnotation] = hco.Namespace + "/" + webhookIssuedCertName
+			mutating.Annotations[certManagerInjectCAAnnotation] = hco.Namespace + "/" + webhookIssuedCertName
+			hco.Spec.CertConfig.IssuerRef = nil
+
+			cli := commontestutils.InitClient([]client.Object{hco, deployment, validating, mutating, issuedSecret()})
+			handler := NewWebhookCertificateWiringHandler(cli, cli)
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			expectWiredTo(cli, webhookDefaultCertName)
+		})
+
+		It("should not add the CA injection annotation to webhook configurations without it", func() {
+			validating.Annotations = nil
+			cli := commontestutils.InitClient([]client.Object{hco, deployment, validating, issuedSecret()})
+			handler := NewWebhookCertificateWiringHandler(cli, cli)
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			foundValidating := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+			Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(validating), foundValidating)).To(Succeed())
+			Expect(foundValidating.Annotations).ToNot(HaveKey(certManagerInjectCAAnnotation))
+		})
+	})
+})
+
+func nestedString(obj *unstructured.Unstructured, fields ...string) string {
+	GinkgoHelper()
+	value, found, err := unstructured.NestedString(obj.Object, fields...)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(BeTrue())
+	return value
+}
+
+func nestedStringMap(obj *unstructured.Unstructured, fields ...string) map[string]string {
+	GinkgoHelper()
+	value, found, err := unstructured.NestedStringMap(obj.Object, fields...)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(BeTrue())
+	return value
+}
+
+func nestedStringSlice(obj *unstructured.Unstructured, fields ...string) []string {
+	GinkgoHelper()
+	value, found, err := unstructured.NestedStringSlice(obj.Object, fields...)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(BeTrue())
+	return value
+}
@@ -0,0 +1,34 @@
+package hyperconverged
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// detectCertIssuerRefIgnored raises the CertIssuerRefIgnored warning condition while spec.certConfig.issuerRef is set,
+// but HCO can't issue the webhook certificate with cert-manager. Like the TaintedConfiguration condition, it is
+// removed rather than set to False.
+func (r *ReconcileHyperConverged) detectCertIssuerRefIgnored(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	conditionExists := apimetav1.IsStatusConditionTrue(req.Instance.Status.Conditions, hcov1beta1.ConditionCertIssuerRefIgnored)
+
+	if reason, message := handlers.GetIgnoredCertIssuerRefReason(req.Instance, hcoutil.GetClusterInfo()); reason != "" {
+		apimetav1.SetStatusCondition(conditions, metav1.Condition{
+			Type:               hcov1beta1.ConditionCertIssuerRefIgnored,
+			Status:             metav1.ConditionTrue,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: req.Instance.Generation,
+		})
+
+		if !conditionExists {
+			req.Logger.Info("spec.certConfig.issuerRef is ignored", "reason", reason)
+		}
+	} else if conditionExists {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionCertIssuerRefIgnored)
+	}
+}
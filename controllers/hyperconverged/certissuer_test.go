@@ -0,0 +1,47 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+var _ = Describe("test the CertIssuerRefIgnored condition", func() {
+	It("should not set the condition if the issuerRef is not set", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		r := &ReconcileHyperConverged{}
+
+		var conditions []metav1.Condition
+		r.detectCertIssuerRefIgnored(req, &conditions)
+
+		Expect(conditions).To(BeEmpty())
+	})
+
+	It("should set the condition if HCO is deployed by OLM, and remove it when the issuerRef is removed", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.CertConfig.IssuerRef = &hcov1beta1.CertManagerIssuerReference{Name: "corporate-ca"}
+		req := commontestutils.NewReq(hco)
+		r := &ReconcileHyperConverged{}
+
+		var conditions []metav1.Condition
+		r.detectCertIssuerRefIgnored(req, &conditions)
+
+		Expect(conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+			Type:    hcov1beta1.ConditionCertIssuerRefIgnored,
+			Status:  metav1.ConditionTrue,
+			Reason:  handlers.CertIssuerRefManagedByOLMReason,
+			Message: "spec.certConfig.issuerRef is ignored, because HCO is deployed by OLM, that manages the webhook certificate; the webhook keeps using the OLM certificate",
+		})))
+
+		hco.Status.Conditions = conditions
+		hco.Spec.CertConfig.IssuerRef = nil
+		r.detectCertIssuerRefIgnored(req, &conditions)
+
+		Expect(conditions).To(BeEmpty())
+	})
+})
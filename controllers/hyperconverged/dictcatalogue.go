@@ -0,0 +1,37 @@
+package hyperconverged
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+)
+
+// applyDataImportCronTemplatesCatalogue reads the external catalogue of the common DataImportCronTemplates, if
+// exists, and updates the HyperConverged status with the catalogue revision that is in effect.
+func (r *ReconcileHyperConverged) applyDataImportCronTemplatesCatalogue(req *common.HcoRequest) {
+	cms := &corev1.ConfigMapList{}
+	err := r.client.List(req.Ctx, cms,
+		client.InNamespace(req.Namespace),
+		client.MatchingLabels{goldenimages.DICTCatalogueLabel: "true"},
+	)
+	if err != nil {
+		req.Logger.Error(err, "failed to read the DataImportCronTemplate catalogue ConfigMap")
+		return
+	}
+
+	status, changed := goldenimages.LoadDataImportCronTemplatesCatalogue(cms.Items)
+	if changed {
+		req.Logger.Info("the DataImportCronTemplate catalogue was changed")
+		// drop the cached SSP CR, to re-generate its DataImportCronTemplates
+		r.operandHandler.Reset()
+	}
+
+	if !reflect.DeepEqual(status, req.Instance.Status.DataImportCronTemplatesCatalogue) {
+		req.Instance.Status.DataImportCronTemplatesCatalogue = status
+		req.StatusDirty = true
+	}
+}
@@ -54,6 +54,7 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/alerts"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operandhandler"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/reqresolver"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
@@ -109,9 +110,10 @@ func RegisterReconciler(mgr manager.Manager,
 	ci hcoutil.ClusterInfo,
 	upgradeableCond hcoutil.Condition,
 	ingressEventCh <-chan event.GenericEvent,
-	nodeEventChannel <-chan event.GenericEvent) error {
+	nodeEventChannel <-chan event.GenericEvent,
+	admissionPolicyEventCh <-chan event.GenericEvent) error {
 
-	return add(mgr, newReconciler(mgr, ci, upgradeableCond), ci, ingressEventCh, nodeEventChannel)
+	return add(mgr, newReconciler(mgr, ci, upgradeableCond), ci, ingressEventCh, nodeEventChannel, admissionPolicyEventCh)
 }
 
 // newReconciler returns a new reconcile.Reconciler
@@ -129,8 +131,9 @@ func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond
 
 	r := &ReconcileHyperConverged{
 		client:               mgr.GetClient(),
+		apiReader:            mgr.GetAPIReader(),
 		scheme:               mgr.GetScheme(),
-		operandHandler:       operandhandler.NewOperandHandler(mgr.GetClient(), mgr.GetScheme(), ci, hcoutil.GetEventEmitter()),
+		operandHandler:       operandhandler.NewOperandHandler(mgr.GetClient(), mgr.GetAPIReader(), mgr.GetScheme(), ci, hcoutil.GetEventEmitter()),
 		upgradeMode:          false,
 		ownVersion:           ownVersion,
 		eventEmitter:         hcoutil.GetEventEmitter(),
@@ -147,7 +150,7 @@ func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond
 }
 
 // newCRDremover returns a new CRDRemover
-func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, ingressEventCh <-chan event.GenericEvent, nodeEventChannel <-chan event.GenericEvent) error {
+func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, ingressEventCh <-chan event.GenericEvent, nodeEventChannel <-chan event.GenericEvent, admissionPolicyEventCh <-chan event.GenericEvent) error {
 	// Create a new controller
 	c, err := controller.New("hyperconverged-controller", mgr, controller.Options{Reconciler: r})
 	if err != nil {
@@ -185,18 +188,23 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 		&rbacv1.RoleBinding{},
 		&rbacv1.ClusterRole{},
 		&rbacv1.ClusterRoleBinding{},
+		&networkingv1.NetworkPolicy{},
 	}
 	if ci.IsMonitoringAvailable() {
 		secondaryResources = append(secondaryResources, []client.Object{
 			&monitoringv1.ServiceMonitor{},
 			&monitoringv1.PrometheusRule{},
-			&networkingv1.NetworkPolicy{},
-			&corev1.Secret{},
 		}...)
 	}
+	// the Secrets of the metrics authorization, and the Secret of the webhook certificate that cert-manager issues
+	if ci.IsMonitoringAvailable() || ci.IsCertManagerAvailable() {
+		secondaryResources = append(secondaryResources, &corev1.Secret{})
+	}
 	if ci.IsOpenshift() {
 		secondaryResources = append(secondaryResources, []client.Object{
 			&sspv1beta3.SSP{},
+			&cdiv1beta1.DataImportCron{},
+			&cdiv1beta1.DataSource{},
 			&corev1.Service{},
 			&routev1.Route{},
 			&consolev1.ConsoleCLIDownload{},
@@ -229,12 +237,50 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 						reqresolver.GetSecondaryCRRequest(),
 					}
 				}),
+				// the ConfigMaps of the openshift-config namespace are only cached for the trusted CA of the
+				// cluster-wide proxy; they are not secondary resources
+				predicate.NewPredicateFuncs(func(obj client.Object) bool {
+					return obj.GetNamespace() != hcoutil.OpenshiftConfigNamespace
+				}),
 			))
 		if err != nil {
 			return err
 		}
 	}
 
+	err = c.Watch(
+		source.Channel(
+			admissionPolicyEventCh,
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+				// the admission policy controller initiate this by pushing an event to the admissionPolicyEventCh
+				// channel, when the status of the VM defaults policies is changed
+				log.Info("Reconciling for the VM defaults admission policies")
+				return []reconcile.Request{
+					reqresolver.GetSecondaryCRRequest(),
+				}
+			}),
+		))
+	if err != nil {
+		return err
+	}
+
+	// the HCO pods are only watched for the digests of their images, in status.operandImages
+	err = c.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			client.Object(&corev1.Pod{}),
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+				log.Info("Reconciling for the image digests of the HCO pods")
+				return []reconcile.Request{
+					reqresolver.GetSecondaryCRRequest(),
+				}
+			}),
+			podImageIDsChangedPredicate(),
+		))
+	if err != nil {
+		return err
+	}
+
 	if ci.IsOpenshift() {
 		err = c.Watch(
 			source.Kind(
@@ -254,6 +300,41 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 			return err
 		}
 
+		err = c.Watch(
+			source.Kind(
+				mgr.GetCache(),
+				client.Object(&openshiftconfigv1.Proxy{}),
+				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+					// enqueue using a placeholder to signal that the cluster-wide proxy configuration should be
+					// reloaded
+					log.Info("Reconciling for openshiftconfigv1.Proxy")
+					return []reconcile.Request{
+						reqresolver.GetProxyCRRequest(),
+					}
+				}),
+			))
+		if err != nil {
+			return err
+		}
+
+		err = c.Watch(
+			source.Kind(
+				mgr.GetCache(),
+				client.Object(&corev1.ConfigMap{}),
+				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+					log.Info("Reconciling for the trusted CA ConfigMap of the cluster-wide proxy")
+					return []reconcile.Request{
+						reqresolver.GetProxyCRRequest(),
+					}
+				}),
+				predicate.NewPredicateFuncs(func(obj client.Object) bool {
+					return isClusterProxyTrustedCA(ci, obj)
+				}),
+			))
+		if err != nil {
+			return err
+		}
+
 		err = c.Watch(
 			source.Channel(
 				ingressEventCh,
@@ -299,6 +380,7 @@ type ReconcileHyperConverged struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	client               client.Client
+	apiReader            client.Reader
 	scheme               *runtime.Scheme
 	operandHandler       *operandhandler.OperandHandler
 	upgradeMode          bool
@@ -322,6 +404,11 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
+	err = r.refreshProxyCR(ctx, logger, request)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	resolvedRequest, hcoTriggered := reqresolver.ResolveReconcileRequest(logger, request)
 	hcoRequest := common.NewHcoRequest(ctx, resolvedRequest, logger, r.upgradeMode, hcoTriggered)
 
@@ -372,6 +459,12 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 	requeue, err := r.updateHyperConverged(hcoRequest)
 	if requeue || apierrors.IsConflict(err) {
 		result.RequeueAfter = requeueAfter
+	} else if result.RequeueAfter == 0 && instance.Status.WorkloadUpdate != nil {
+		// keep tracking the workload update progress, even if nothing else triggers a reconciliation
+		result.RequeueAfter = workloadUpdateRequeueAfter
+	} else if result.RequeueAfter == 0 {
+		// make sure the metrics bearer token is rotated before it expires, even if nothing else triggers a reconciliation
+		result.RequeueAfter = r.monitoringReconciler.GetTokenRotationCheckInterval()
 	}
 
 	return result, err
@@ -387,6 +480,27 @@ func (r *ReconcileHyperConverged) refreshAPIServerCR(ctx context.Context, logger
 	return nil
 }
 
+// refreshProxyCR refreshes the cluster-wide proxy configuration, if the request is triggered by the Proxy CR or by its
+// trusted CA ConfigMap.
+func (r *ReconcileHyperConverged) refreshProxyCR(ctx context.Context, logger logr.Logger, originalRequest reconcile.Request) error {
+	if reqresolver.IsTriggeredByProxyCR(originalRequest) {
+		logger.Info("Refreshing the Proxy CR")
+		return hcoutil.GetClusterInfo().RefreshProxyCR(ctx, r.client)
+	}
+
+	return nil
+}
+
+// isClusterProxyTrustedCA returns true if obj is the trusted CA ConfigMap of the cluster-wide proxy
+func isClusterProxyTrustedCA(ci hcoutil.ClusterInfo, obj client.Object) bool {
+	if obj.GetNamespace() != hcoutil.OpenshiftConfigNamespace {
+		return false
+	}
+
+	proxy := ci.GetClusterProxy()
+	return proxy != nil && proxy.TrustedCAConfigMapName == obj.GetName()
+}
+
 func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile.Result, error) {
 
 	valid := r.validateNamespace(req)
@@ -427,6 +541,17 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 
 	applyDataImportSchedule(req)
 
+	r.applyDataImportCronTemplatesCatalogue(req)
+
+	r.updateWorkloadUpdateStatus(req)
+
+	r.updateNetworkBindingPluginsStatus(req)
+	r.updateNetworkingStatus(req)
+	r.updateKubeSecondaryDNSStatus(req)
+	updateImportProxyStatus(req)
+	r.updateOperandImagesStatus(req)
+	updateVirtualMachineDefaultsStatus(req)
+
 	// If the current version is not updated in CR ,then we're updating. This is also works when updating from
 	// an old version, since Status.Versions will be empty.
 	knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
@@ -514,6 +639,11 @@ func updateStatus(req *common.HcoRequest) {
 		req.Instance.Status.NodeInfo.WorkloadsArchitectures = workloadsArch
 		req.StatusDirty = true
 	}
+
+	if tlsProfiles := handlers.GetComponentsTLSSecurityProfiles(req.Instance); !reflect.DeepEqual(req.Instance.Status.TLSSecurityProfiles, tlsProfiles) {
+		req.Instance.Status.TLSSecurityProfiles = tlsProfiles
+		req.StatusDirty = true
+	}
 }
 
 // getHyperConverged gets the HyperConverged resource from the Kubernetes API.
@@ -965,6 +1095,12 @@ func (r *ReconcileHyperConverged) updateConditions(req *common.HcoRequest) {
 	// Detect a "TaintedConfiguration" state, and raise a corresponding event
 	r.detectTaintedConfiguration(req, &conditions)
 
+	// Warn about disabled NetworkPolicies
+	r.detectNetworkPoliciesDisabled(req, &conditions)
+
+	// Warn about a cert-manager issuer that can't be used
+	r.detectCertIssuerRefIgnored(req, &conditions)
+
 	if !reflect.DeepEqual(conditions, req.Instance.Status.Conditions) {
 		req.Instance.Status.Conditions = conditions
 		req.StatusDirty = true
@@ -237,7 +237,7 @@ var _ = Describe("HyperconvergedController", func() {
 
 				res, err = r.Reconcile(context.TODO(), request)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(res.IsZero()).To(BeTrue())
+				Expect(res).To(Equal(reconcile.Result{RequeueAfter: monitoringReconciler.GetTokenRotationCheckInterval()}))
 				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
 				verifyHyperConvergedCRExistsMetricTrue()
 
@@ -293,7 +293,7 @@ var _ = Describe("HyperconvergedController", func() {
 				// Do the reconcile
 				res, err := r.Reconcile(context.TODO(), request)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(res).To(Equal(reconcile.Result{}))
+				Expect(res).To(Equal(reconcile.Result{RequeueAfter: r.monitoringReconciler.GetTokenRotationCheckInterval()}))
 
 				verifyHyperConvergedCRExistsMetricTrue()
 
@@ -803,7 +803,7 @@ var _ = Describe("HyperconvergedController", func() {
 
 				res, err := r.Reconcile(context.TODO(), request)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(res).To(Equal(reconcile.Result{}))
+				Expect(res).To(Equal(reconcile.Result{RequeueAfter: monitoringReconciler.GetTokenRotationCheckInterval()}))
 
 				foundResource := &hcov1beta1.HyperConverged{}
 				Expect(
@@ -0,0 +1,19 @@
+package hyperconverged
+
+import (
+	"reflect"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+// updateImportProxyStatus reports the effective proxy configuration of the CDI importer pods, with masked credentials,
+// in the HyperConverged status.importProxy field
+func updateImportProxyStatus(req *common.HcoRequest) {
+	status := handlers.GetImportProxyStatus(req.Instance)
+
+	if !reflect.DeepEqual(status, req.Instance.Status.ImportProxy) {
+		req.Instance.Status.ImportProxy = status
+		req.StatusDirty = true
+	}
+}
@@ -0,0 +1,19 @@
+package hyperconverged
+
+import (
+	"reflect"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+// updateKubeSecondaryDNSStatus reports the KubeSecondaryDNS domain and external address in the HyperConverged
+// status.kubeSecondaryDNS field
+func (r *ReconcileHyperConverged) updateKubeSecondaryDNSStatus(req *common.HcoRequest) {
+	status := handlers.GetKubeSecondaryDNSStatus(req.Ctx, r.client, req.Instance)
+
+	if !reflect.DeepEqual(status, req.Instance.Status.KubeSecondaryDNS) {
+		req.Instance.Status.KubeSecondaryDNS = status
+		req.StatusDirty = true
+	}
+}
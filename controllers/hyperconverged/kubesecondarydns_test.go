@@ -0,0 +1,59 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+var _ = Describe("test KubeSecondaryDNS status", func() {
+	It("should not set the status if KubeSecondaryDNS is not deployed", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco})
+		r := &ReconcileHyperConverged{client: cl}
+
+		r.updateKubeSecondaryDNSStatus(req)
+
+		Expect(hco.Status.KubeSecondaryDNS).To(BeNil())
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should report the domain and the external address, and clear them when KubeSecondaryDNS is removed", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.KubeSecondaryDNS = &hcov1beta1.KubeSecondaryDNSConfig{
+			Deploy:   ptr.To(true),
+			Domain:   "vms.example.com",
+			Exposure: &hcov1beta1.KubeSecondaryDNSExposure{ServiceType: corev1.ServiceTypeLoadBalancer},
+		}
+
+		svc := handlers.NewKubeSecondaryDNSService(hco)
+		svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "dns.example.com"}}
+
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco, svc})
+		r := &ReconcileHyperConverged{client: cl}
+
+		r.updateKubeSecondaryDNSStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.KubeSecondaryDNS).To(Equal(&hcov1beta1.KubeSecondaryDNSStatus{
+			Domain:          "vms.example.com",
+			ExternalAddress: "dns.example.com",
+			ExternalPort:    53,
+		}))
+
+		req.StatusDirty = false
+		hco.Spec.KubeSecondaryDNS = nil
+		r.updateKubeSecondaryDNSStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.KubeSecondaryDNS).To(BeNil())
+	})
+})
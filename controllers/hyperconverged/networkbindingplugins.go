@@ -0,0 +1,19 @@
+package hyperconverged
+
+import (
+	"reflect"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+// updateNetworkBindingPluginsStatus reports the state of the enabled network binding plugins in the HyperConverged
+// status.networkBindingPlugins field
+func (r *ReconcileHyperConverged) updateNetworkBindingPluginsStatus(req *common.HcoRequest) {
+	status := handlers.GetNetworkBindingPluginsStatus(req.Ctx, r.client, req.Instance)
+
+	if !reflect.DeepEqual(status, req.Instance.Status.NetworkBindingPlugins) {
+		req.Instance.Status.NetworkBindingPlugins = status
+		req.StatusDirty = true
+	}
+}
@@ -0,0 +1,74 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/passt"
+)
+
+var _ = Describe("test network binding plugins status", func() {
+	It("should not set the status if no plugin is enabled", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco})
+		r := &ReconcileHyperConverged{client: cl}
+
+		r.updateNetworkBindingPluginsStatus(req)
+
+		Expect(hco.Status.NetworkBindingPlugins).To(BeEmpty())
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should report the state of the enabled plugins", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.NetworkBindingPlugins = []hcov1beta1.NetworkBindingPlugin{
+			{Name: hcov1beta1.NetworkBindingPluginPasst},
+			{Name: hcov1beta1.NetworkBindingPluginManagedTap},
+		}
+
+		ds := passt.NewPasstBindingCNIDaemonSet(hco)
+		ds.Status.DesiredNumberScheduled = 3
+		ds.Status.UpdatedNumberScheduled = 3
+		ds.Status.NumberReady = 2
+
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco, ds})
+		r := &ReconcileHyperConverged{client: cl}
+
+		r.updateNetworkBindingPluginsStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.NetworkBindingPlugins).To(HaveLen(2))
+
+		passtStatus := hco.Status.NetworkBindingPlugins[0]
+		Expect(passtStatus.Name).To(Equal(hcov1beta1.NetworkBindingPluginPasst))
+		Expect(passtStatus.BindingName).To(Equal(passt.BindingName))
+		Expect(passtStatus.Ready).To(BeFalse())
+		Expect(passtStatus.Message).To(ContainSubstring("2 of 3 pods are ready"))
+
+		Expect(hco.Status.NetworkBindingPlugins[1]).To(Equal(hcov1beta1.NetworkBindingPluginStatus{
+			Name:        hcov1beta1.NetworkBindingPluginManagedTap,
+			BindingName: "managedtap",
+			Ready:       true,
+		}))
+	})
+
+	It("should clear the status when the plugins are removed", func() {
+		hco := commontestutils.NewHco()
+		hco.Status.NetworkBindingPlugins = []hcov1beta1.NetworkBindingPluginStatus{
+			{Name: hcov1beta1.NetworkBindingPluginManagedTap, BindingName: "managedtap", Ready: true},
+		}
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco})
+		r := &ReconcileHyperConverged{client: cl}
+
+		r.updateNetworkBindingPluginsStatus(req)
+
+		Expect(hco.Status.NetworkBindingPlugins).To(BeEmpty())
+		Expect(req.StatusDirty).To(BeTrue())
+	})
+})
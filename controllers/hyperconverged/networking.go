@@ -0,0 +1,18 @@
+package hyperconverged
+
+import (
+	"reflect"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+// updateNetworkingStatus reports the state of the network components in the HyperConverged status.networking field
+func (r *ReconcileHyperConverged) updateNetworkingStatus(req *common.HcoRequest) {
+	status := handlers.GetNetworkComponentsStatus(req.Ctx, r.client, req.Instance)
+
+	if !reflect.DeepEqual(status, req.Instance.Status.Networking) {
+		req.Instance.Status.Networking = status
+		req.StatusDirty = true
+	}
+}
@@ -0,0 +1,58 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+var _ = Describe("test networking status", func() {
+	It("should not set the status if the NetworkAddonsConfig does not exist", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco})
+		r := &ReconcileHyperConverged{client: cl}
+
+		r.updateNetworkingStatus(req)
+
+		Expect(hco.Status.Networking).To(BeEmpty())
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should report the state of the deployed network components", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.Networking = &hcov1beta1.NetworkingConfig{
+			LinuxBridge: &hcov1beta1.NetworkComponentConfig{Deploy: ptr.To(false)},
+		}
+
+		cna, err := handlers.NewNetworkAddons(hco)
+		Expect(err).ToNot(HaveOccurred())
+		cna.Status.Conditions = []conditionsv1.Condition{
+			{Type: conditionsv1.ConditionAvailable, Status: corev1.ConditionTrue},
+			{Type: conditionsv1.ConditionProgressing, Status: corev1.ConditionTrue, Message: `DaemonSet "cluster-network-addons/multus" is not available (awaiting 1 nodes)`},
+		}
+
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco, cna})
+		r := &ReconcileHyperConverged{client: cl}
+
+		r.updateNetworkingStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.Networking).To(Equal([]hcov1beta1.NetworkComponentStatus{
+			{Name: hcov1beta1.NetworkComponentMultus, Message: `DaemonSet "cluster-network-addons/multus" is not available (awaiting 1 nodes)`},
+			{Name: hcov1beta1.NetworkComponentKubevirtIpamController, Ready: true},
+		}))
+
+		req.StatusDirty = false
+		r.updateNetworkingStatus(req)
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+})
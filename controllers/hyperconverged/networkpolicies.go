@@ -0,0 +1,39 @@
+package hyperconverged
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+const (
+	networkPoliciesDisabledReason  = "NetworkPoliciesDisabled"
+	networkPoliciesDisabledMessage = "The NetworkPolicies of the HCO pods are disabled by spec.networkPolicies.disabled; all the traffic of the HCO pods is allowed"
+)
+
+// detectNetworkPoliciesDisabled raises the NetworkPoliciesDisabled warning condition while the NetworkPolicies of
+// the HCO pods are disabled. Like the TaintedConfiguration condition, it is removed rather than set to False.
+func (r *ReconcileHyperConverged) detectNetworkPoliciesDisabled(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	conditionExists := apimetav1.IsStatusConditionTrue(req.Instance.Status.Conditions, hcov1beta1.ConditionNetworkPoliciesDisabled)
+
+	if handlers.IsNetworkPoliciesDisabled(req.Instance) {
+		apimetav1.SetStatusCondition(conditions, metav1.Condition{
+			Type:               hcov1beta1.ConditionNetworkPoliciesDisabled,
+			Status:             metav1.ConditionTrue,
+			Reason:             networkPoliciesDisabledReason,
+			Message:            networkPoliciesDisabledMessage,
+			ObservedGeneration: req.Instance.Generation,
+		})
+
+		if !conditionExists {
+			req.Logger.Info("The NetworkPolicies of the HCO pods are disabled")
+		}
+	} else if conditionExists {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionNetworkPoliciesDisabled)
+
+		req.Logger.Info("The NetworkPolicies of the HCO pods are enabled")
+	}
+}
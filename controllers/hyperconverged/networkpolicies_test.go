@@ -0,0 +1,47 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("test the NetworkPoliciesDisabled condition", func() {
+	It("should not set the condition if the NetworkPolicies are not disabled", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		r := &ReconcileHyperConverged{}
+
+		conditions := []metav1.Condition{}
+		r.detectNetworkPoliciesDisabled(req, &conditions)
+
+		Expect(conditions).To(BeEmpty())
+	})
+
+	It("should set the condition if the NetworkPolicies are disabled, and remove it when they are enabled again", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.NetworkPolicies = &hcov1beta1.NetworkPoliciesConfig{Disabled: ptr.To(true)}
+		req := commontestutils.NewReq(hco)
+		r := &ReconcileHyperConverged{}
+
+		var conditions []metav1.Condition
+		r.detectNetworkPoliciesDisabled(req, &conditions)
+
+		Expect(conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+			Type:    hcov1beta1.ConditionNetworkPoliciesDisabled,
+			Status:  metav1.ConditionTrue,
+			Reason:  networkPoliciesDisabledReason,
+			Message: networkPoliciesDisabledMessage,
+		})))
+
+		hco.Status.Conditions = conditions
+		hco.Spec.NetworkPolicies.Disabled = ptr.To(false)
+		r.detectNetworkPoliciesDisabled(req, &conditions)
+
+		Expect(conditions).To(BeEmpty())
+	})
+})
@@ -0,0 +1,110 @@
+package hyperconverged
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// updateOperandImagesStatus lists the images that HCO deploys, or passes to the operands, and the images of the
+// operators of the operands, with their digests, in the HyperConverged status.operandImages field
+func (r *ReconcileHyperConverged) updateOperandImagesStatus(req *common.HcoRequest) {
+	operatorImages, err := handlers.GetOperatorImages(req, r.apiReader)
+	if err != nil {
+		// keep the current status, rather than dropping the operator images from it
+		req.Logger.Error(err, "failed to read the images of the operators of the operands")
+		return
+	}
+
+	images := append(handlers.GetOperandImages(), operatorImages...)
+
+	var podImageDigests map[string]string
+	for i := range images {
+		if digest := getImageReferenceDigest(images[i].Image); digest != "" {
+			images[i].Digest = digest
+			continue
+		}
+
+		// the image is referenced by tag; look for a running pod that pulled it
+		if podImageDigests == nil {
+			podImageDigests = r.getPodImageDigests(req)
+		}
+		images[i].Digest = podImageDigests[images[i].Image]
+	}
+
+	if !reflect.DeepEqual(images, req.Instance.Status.OperandImages) {
+		req.Instance.Status.OperandImages = images
+		req.StatusDirty = true
+	}
+}
+
+// getPodImageDigests maps the images of the running containers of the HCO pods, to the digests that the nodes
+// resolved them to
+func (r *ReconcileHyperConverged) getPodImageDigests(req *common.HcoRequest) map[string]string {
+	digests := make(map[string]string)
+
+	pods := &corev1.PodList{}
+	err := r.client.List(req.Ctx, pods,
+		client.InNamespace(req.Namespace),
+		client.MatchingLabels{hcoutil.AppLabel: hcoutil.HyperConvergedName},
+	)
+	if err != nil {
+		req.Logger.Error(err, "failed to list the pods, to read the image digests")
+		return digests
+	}
+
+	for _, pod := range pods.Items {
+		imageIDs := getContainerImageIDs(&pod)
+		for _, container := range pod.Spec.Containers {
+			if digest := getImageReferenceDigest(imageIDs[container.Name]); digest != "" {
+				digests[container.Image] = digest
+			}
+		}
+	}
+
+	return digests
+}
+
+// podImageIDsChangedPredicate filters the pod events, to the updates that change the image IDs of the pod containers;
+// i.e., when a container starts running a newly pulled image
+func podImageIDsChangedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldPod, ok1 := e.ObjectOld.(*corev1.Pod)
+			newPod, ok2 := e.ObjectNew.(*corev1.Pod)
+			if !ok1 || !ok2 {
+				return false
+			}
+
+			return !maps.Equal(getContainerImageIDs(oldPod), getContainerImageIDs(newPod))
+		},
+	}
+}
+
+func getContainerImageIDs(pod *corev1.Pod) map[string]string {
+	imageIDs := make(map[string]string, len(pod.Status.ContainerStatuses))
+	for _, status := range pod.Status.ContainerStatuses {
+		imageIDs[status.Name] = status.ImageID
+	}
+
+	return imageIDs
+}
+
+// getImageReferenceDigest returns the digest of an image that is referenced by digest (<repository>@<digest>), or
+// an empty string if the image is referenced by tag
+func getImageReferenceDigest(image string) string {
+	_, digest, _ := strings.Cut(image, "@")
+	return digest
+}
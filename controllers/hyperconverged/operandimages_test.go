@@ -0,0 +1,160 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("test operand images status", func() {
+	const (
+		pluginDigest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		proxyDigest  = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+
+		pluginImage = "quay.io/kubevirt-ui/kubevirt-plugin@" + pluginDigest
+		proxyImage  = "quay.io/kubevirt-ui/kubevirt-apiserver-proxy:latest"
+	)
+
+	BeforeEach(func() {
+		for _, envVar := range []string{
+			hcoutil.OperatorImageEnvV,
+			hcoutil.KVUIPluginImageEnvV,
+			hcoutil.KVUIProxyImageEnvV,
+			hcoutil.PasstImageEnvV,
+			hcoutil.PasstCNIImageEnvV,
+			hcoutil.WaspAgentImageEnvV,
+			hcoutil.VirtioWinImageEnvV,
+		} {
+			GinkgoT().Setenv(envVar, "")
+		}
+	})
+
+	newProxyPod := func(imageID string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt-apiserver-proxy-12345",
+				Namespace: commontestutils.Namespace,
+				Labels:    map[string]string{hcoutil.AppLabel: hcoutil.HyperConvergedName},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "kubevirt-apiserver-proxy", Image: proxyImage}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "kubevirt-apiserver-proxy", ImageID: imageID}},
+			},
+		}
+	}
+
+	It("should not set the status if no image is configured", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco})
+		r := &ReconcileHyperConverged{client: cl, apiReader: cl}
+
+		r.updateOperandImagesStatus(req)
+
+		Expect(hco.Status.OperandImages).To(BeNil())
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should list the images with the digest of the reference, or of the running pod", func() {
+		GinkgoT().Setenv(hcoutil.KVUIPluginImageEnvV, pluginImage)
+		GinkgoT().Setenv(hcoutil.KVUIProxyImageEnvV, proxyImage)
+
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco, newProxyPod("docker-pullable://quay.io/kubevirt-ui/kubevirt-apiserver-proxy@" + proxyDigest)})
+		r := &ReconcileHyperConverged{client: cl, apiReader: cl}
+
+		r.updateOperandImagesStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.OperandImages).To(Equal([]hcov1beta1.OperandImage{
+			{Name: string(hcoutil.AppComponentUIPlugin), Image: pluginImage, Digest: pluginDigest},
+			{Name: string(hcoutil.AppComponentUIProxy), Image: proxyImage, Digest: proxyDigest},
+		}))
+
+		req.StatusDirty = false
+		r.updateOperandImagesStatus(req)
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should not set the digest of an image that is referenced by tag, if no pod runs it", func() {
+		GinkgoT().Setenv(hcoutil.KVUIProxyImageEnvV, proxyImage)
+
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{hco, newProxyPod("")})
+		r := &ReconcileHyperConverged{client: cl, apiReader: cl}
+
+		r.updateOperandImagesStatus(req)
+
+		Expect(hco.Status.OperandImages).To(Equal([]hcov1beta1.OperandImage{
+			{Name: string(hcoutil.AppComponentUIProxy), Image: proxyImage},
+		}))
+	})
+
+	It("should list the images of the operators of the operands, from their deployments", func() {
+		const (
+			virtOperatorImage = "quay.io/kubevirt/virt-operator@sha256:3333333333333333333333333333333333333333333333333333333333333333"
+			cnaoImage         = "quay.io/kubevirt/cluster-network-addons-operator:v0.99.0"
+		)
+
+		newOperatorDeployment := func(name string, containers ...corev1.Container) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: commontestutils.Namespace,
+					Labels:    map[string]string{hcoutil.AppLabelPartOf: hcoutil.HyperConvergedCluster},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: containers},
+					},
+				},
+			}
+		}
+
+		GinkgoT().Setenv(hcoutil.KVUIPluginImageEnvV, pluginImage)
+
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+		cl := commontestutils.InitClient([]client.Object{
+			hco,
+			newOperatorDeployment("virt-operator", corev1.Container{Name: "virt-operator", Image: virtOperatorImage}),
+			newOperatorDeployment("cluster-network-addons-operator",
+				corev1.Container{Name: "cluster-network-addons-operator", Image: cnaoImage},
+				corev1.Container{Name: "kube-rbac-proxy", Image: "quay.io/openshift/origin-kube-rbac-proxy:latest"},
+			),
+		})
+		r := &ReconcileHyperConverged{client: cl, apiReader: cl}
+
+		r.updateOperandImagesStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.OperandImages).To(Equal([]hcov1beta1.OperandImage{
+			{Name: string(hcoutil.AppComponentUIPlugin), Image: pluginImage, Digest: pluginDigest},
+			{Name: "virt-operator", Image: virtOperatorImage, Digest: "sha256:3333333333333333333333333333333333333333333333333333333333333333"},
+			{Name: "cluster-network-addons-operator", Image: cnaoImage},
+		}))
+	})
+
+	It("should only reconcile for the pod updates that change the image IDs", func() {
+		pred := podImageIDsChangedPredicate()
+		pendingPod := newProxyPod("")
+		runningPod := newProxyPod("quay.io/kubevirt-ui/kubevirt-apiserver-proxy@" + proxyDigest)
+
+		Expect(pred.Create(event.CreateEvent{Object: runningPod})).To(BeFalse())
+		Expect(pred.Delete(event.DeleteEvent{Object: runningPod})).To(BeFalse())
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: pendingPod, ObjectNew: runningPod})).To(BeTrue())
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: runningPod, ObjectNew: runningPod.DeepCopy()})).To(BeFalse())
+	})
+})
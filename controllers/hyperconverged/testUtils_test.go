@@ -58,7 +58,7 @@ func initReconciler(cli client.Client, old *ReconcileHyperConverged) *ReconcileH
 	s := commontestutils.GetScheme()
 	eventEmitter := commontestutils.NewEventEmitterMock()
 	ci := commontestutils.ClusterInfoMock{}
-	operandHandler := operandhandler.NewOperandHandler(cli, s, ci, eventEmitter)
+	operandHandler := operandhandler.NewOperandHandler(cli, cli, s, ci, eventEmitter)
 	upgradeMode := false
 	firstLoop := true
 	upgradeableCondition := newStubOperatorCondition()
@@ -71,6 +71,7 @@ func initReconciler(cli client.Client, old *ReconcileHyperConverged) *ReconcileH
 	// Create a ReconcileHyperConverged object with the scheme and fake client
 	return &ReconcileHyperConverged{
 		client:               cli,
+		apiReader:            cli,
 		scheme:               s,
 		operandHandler:       operandHandler,
 		eventEmitter:         eventEmitter,
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/blang/semver/v4"
 	. "github.com/onsi/ginkgo/v2"
@@ -605,6 +606,32 @@ var _ = Describe("Upgrade Mode", func() {
 			Expect(requeue).To(BeFalse())
 			Expect(foundResource.Spec.LiveMigrationConfig.BandwidthPerMigration).To(HaveValue(Equal(badBandwidthPerMigration)))
 		})
+
+		It("should restore spec.workloadUpdateStrategy.updateStallThreshold when upgrading from < 1.18.0", func() {
+			UpdateVersion(&expected.hco.Status, hcoVersionName, "1.17.0")
+			expected.hco.Spec.WorkloadUpdateStrategy.UpdateStallThreshold = nil
+
+			cl := expected.initClient()
+			_, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+			Expect(requeue).To(BeTrue())
+			foundResource, _, _ := doReconcile(cl, expected.hco, reconciler)
+			Expect(foundResource.Spec.WorkloadUpdateStrategy.UpdateStallThreshold).To(HaveValue(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+
+		It("should not restore spec.workloadUpdateStrategy.updateStallThreshold when upgrading from >= 1.18.0", func() {
+			newVersion := semver.MustParse("1.18.0")
+			newVersion.Patch++
+			Expect(os.Setenv(hcoutil.HcoKvIoVersionName, newVersion.String())).To(Succeed())
+
+			UpdateVersion(&expected.hco.Status, hcoVersionName, "1.18.0")
+			expected.hco.Spec.WorkloadUpdateStrategy.UpdateStallThreshold = nil
+
+			cl := expected.initClient()
+			_, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+			Expect(requeue).To(BeTrue())
+			foundResource, _, _ := doReconcile(cl, expected.hco, reconciler)
+			Expect(foundResource.Spec.WorkloadUpdateStrategy.UpdateStallThreshold).To(BeNil())
+		})
 	})
 
 	Context("remove old quickstart guides", func() {
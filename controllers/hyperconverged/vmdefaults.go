@@ -0,0 +1,19 @@
+package hyperconverged
+
+import (
+	"reflect"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/admissionpolicy"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// updateVirtualMachineDefaultsStatus reports the status of the VM defaults policies, as found by the admission policy
+// controller, in the HyperConverged status.virtualMachineDefaults field
+func updateVirtualMachineDefaultsStatus(req *common.HcoRequest) {
+	status := admissionpolicy.GetVirtualMachineDefaultsStatus()
+
+	if !reflect.DeepEqual(status, req.Instance.Status.VirtualMachineDefaults) {
+		req.Instance.Status.VirtualMachineDefaults = status
+		req.StatusDirty = true
+	}
+}
@@ -0,0 +1,197 @@
+package hyperconverged
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+const (
+	// blockedVMsSampleSize is the maximum number of blocked VMIs to list in the HyperConverged status
+	blockedVMsSampleSize = 10
+
+	// workloadUpdateRequeueAfter is the interval to re-check the workload update progress, while the update is running
+	workloadUpdateRequeueAfter = time.Minute
+
+	defaultUpdateStallThreshold = time.Hour
+
+	workloadUpdateMethodLiveMigrate = "LiveMigrate"
+	workloadUpdateMethodEvict       = "Evict"
+
+	notLiveMigratableReason = "NotLiveMigratable"
+)
+
+// updateWorkloadUpdateStatus reads the number of the outdated VMIs from the KubeVirt CR, and the state of each
+// outdated VMI, and summarizes them in the HyperConverged status.workloadUpdate field.
+func (r *ReconcileHyperConverged) updateWorkloadUpdateStatus(req *common.HcoRequest) {
+	outdated, err := r.getOutdatedVMICount(req)
+	if err != nil {
+		req.Logger.Error(err, "failed to read the number of the outdated VirtualMachineInstances from the KubeVirt CR")
+		return
+	}
+
+	if outdated == 0 {
+		if req.Instance.Status.WorkloadUpdate != nil {
+			req.Logger.Info("workload update completed")
+			req.Instance.Status.WorkloadUpdate = nil
+			req.StatusDirty = true
+		}
+		setWorkloadUpdateMetrics(&hcov1beta1.WorkloadUpdateStatus{}, false)
+		return
+	}
+
+	vmis := &kubevirtcorev1.VirtualMachineInstanceList{}
+	if err = r.client.List(req.Ctx, vmis, client.HasLabels{kubevirtcorev1.OutdatedLauncherImageLabel}); err != nil {
+		req.Logger.Error(err, "failed to read the outdated VirtualMachineInstances")
+		return
+	}
+
+	status := summarizeOutdatedVMIs(vmis.Items, req.Instance.Spec)
+	status.OutdatedVirtualMachineInstances = outdated
+
+	prevStatus := req.Instance.Status.WorkloadUpdate
+	if prevStatus == nil || prevStatus.LastProgressTime == nil || outdated < prevStatus.OutdatedVirtualMachineInstances {
+		status.LastProgressTime = ptr.To(metav1.Now())
+	} else {
+		status.LastProgressTime = prevStatus.LastProgressTime
+	}
+
+	setWorkloadUpdateMetrics(status, isWorkloadUpdateStalled(status, req.Instance.Spec.WorkloadUpdateStrategy))
+
+	if !reflect.DeepEqual(prevStatus, status) {
+		req.Instance.Status.WorkloadUpdate = status
+		req.StatusDirty = true
+	}
+}
+
+func (r *ReconcileHyperConverged) getOutdatedVMICount(req *common.HcoRequest) (int, error) {
+	kv := handlers.NewKubeVirtWithNameOnly(req.Instance)
+	if err := r.client.Get(req.Ctx, client.ObjectKeyFromObject(kv), kv); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return ptr.Deref(kv.Status.OutdatedVirtualMachineInstanceWorkloads, 0), nil
+}
+
+func summarizeOutdatedVMIs(vmis []kubevirtcorev1.VirtualMachineInstance, spec hcov1beta1.HyperConvergedSpec) *hcov1beta1.WorkloadUpdateStatus {
+	status := &hcov1beta1.WorkloadUpdateStatus{}
+	var blocked []hcov1beta1.BlockedVirtualMachine
+
+	for _, vmi := range vmis {
+		if vmi.IsFinal() {
+			continue
+		}
+
+		if isVMIMigrating(&vmi) {
+			status.MigratingVirtualMachineInstances++
+			continue
+		}
+
+		if reason, isBlocked := getVMIBlockedReason(&vmi, spec); isBlocked {
+			status.BlockedVirtualMachineInstances++
+			blocked = append(blocked, hcov1beta1.BlockedVirtualMachine{
+				Namespace: vmi.Namespace,
+				Name:      vmi.Name,
+				Reason:    reason,
+			})
+			continue
+		}
+
+		status.PendingVirtualMachineInstances++
+	}
+
+	if len(blocked) > 0 {
+		slices.SortFunc(blocked, func(a, b hcov1beta1.BlockedVirtualMachine) int {
+			return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+		})
+		status.BlockedVirtualMachines = blocked[:min(len(blocked), blockedVMsSampleSize)]
+	}
+
+	return status
+}
+
+func isVMIMigrating(vmi *kubevirtcorev1.VirtualMachineInstance) bool {
+	state := vmi.Status.MigrationState
+	return state != nil && !state.Completed && !state.Failed
+}
+
+// getVMIBlockedReason checks if an outdated VMI can be updated by KubeVirt, using the configured workload update
+// methods. If not, it returns the reason.
+func getVMIBlockedReason(vmi *kubevirtcorev1.VirtualMachineInstance, spec hcov1beta1.HyperConvergedSpec) (string, bool) {
+	methods := spec.WorkloadUpdateStrategy.WorkloadUpdateMethods
+	if len(methods) == 0 {
+		return "the automated workload update is disabled", true
+	}
+
+	migratable, notMigratableReason := isVMILiveMigratable(vmi)
+	if migratable && slices.Contains(methods, workloadUpdateMethodLiveMigrate) {
+		return "", false
+	}
+
+	if !slices.Contains(methods, workloadUpdateMethodEvict) {
+		return fmt.Sprintf("the VirtualMachineInstance is not live-migratable (%s), and the %s workload update method is not enabled", notMigratableReason, workloadUpdateMethodEvict), true
+	}
+
+	evictionStrategy := vmi.Spec.EvictionStrategy
+	if evictionStrategy == nil {
+		evictionStrategy = spec.EvictionStrategy
+	}
+
+	if !migratable && ptr.Deref(evictionStrategy, "") == kubevirtcorev1.EvictionStrategyLiveMigrate {
+		return fmt.Sprintf("the VirtualMachineInstance is not live-migratable (%s), and its eviction is blocked by the %s eviction strategy", notMigratableReason, kubevirtcorev1.EvictionStrategyLiveMigrate), true
+	}
+
+	return "", false
+}
+
+func isVMILiveMigratable(vmi *kubevirtcorev1.VirtualMachineInstance) (bool, string) {
+	for _, cond := range vmi.Status.Conditions {
+		if cond.Type == kubevirtcorev1.VirtualMachineInstanceIsMigratable {
+			if cond.Status == corev1.ConditionTrue {
+				return true, ""
+			}
+			return false, cmp.Or(cond.Reason, notLiveMigratableReason)
+		}
+	}
+
+	return false, notLiveMigratableReason
+}
+
+func isWorkloadUpdateStalled(status *hcov1beta1.WorkloadUpdateStatus, strategy hcov1beta1.HyperConvergedWorkloadUpdateStrategy) bool {
+	// no automated workload update; nothing is expected to progress
+	if len(strategy.WorkloadUpdateMethods) == 0 || status.LastProgressTime == nil {
+		return false
+	}
+
+	threshold := defaultUpdateStallThreshold
+	if strategy.UpdateStallThreshold != nil {
+		threshold = strategy.UpdateStallThreshold.Duration
+	}
+
+	return time.Since(status.LastProgressTime.Time) > threshold
+}
+
+func setWorkloadUpdateMetrics(status *hcov1beta1.WorkloadUpdateStatus, stalled bool) {
+	metrics.SetWorkloadUpdateOutdatedVMIs(metrics.WorkloadUpdateStatePending, status.PendingVirtualMachineInstances)
+	metrics.SetWorkloadUpdateOutdatedVMIs(metrics.WorkloadUpdateStateMigrating, status.MigratingVirtualMachineInstances)
+	metrics.SetWorkloadUpdateOutdatedVMIs(metrics.WorkloadUpdateStateBlocked, status.BlockedVirtualMachineInstances)
+	metrics.SetWorkloadUpdateStalled(stalled)
+}
@@ -0,0 +1,242 @@
+package hyperconverged
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+var _ = Describe("test workload update status", func() {
+	newOutdatedVMI := func(name string, migratable bool) *kubevirtcorev1.VirtualMachineInstance {
+		status := corev1.ConditionFalse
+		if migratable {
+			status = corev1.ConditionTrue
+		}
+
+		return &kubevirtcorev1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels: map[string]string{
+					kubevirtcorev1.OutdatedLauncherImageLabel: "",
+				},
+			},
+			Status: kubevirtcorev1.VirtualMachineInstanceStatus{
+				Phase: kubevirtcorev1.Running,
+				Conditions: []kubevirtcorev1.VirtualMachineInstanceCondition{
+					{
+						Type:   kubevirtcorev1.VirtualMachineInstanceIsMigratable,
+						Status: status,
+						Reason: "DisksNotLiveMigratable",
+					},
+				},
+			},
+		}
+	}
+
+	newKubeVirt := func(hco *hcov1beta1.HyperConverged, outdated int) *kubevirtcorev1.KubeVirt {
+		kv := handlers.NewKubeVirtWithNameOnly(hco)
+		kv.Status.OutdatedVirtualMachineInstanceWorkloads = ptr.To(outdated)
+		return kv
+	}
+
+	Context("getVMIBlockedReason", func() {
+		It("should not block a live-migratable VMI", func() {
+			hco := commontestutils.NewHco()
+			reason, blocked := getVMIBlockedReason(newOutdatedVMI("vmi", true), hco.Spec)
+			Expect(blocked).To(BeFalse())
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("should block all the VMIs if the automated workload update is disabled", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = nil
+
+			reason, blocked := getVMIBlockedReason(newOutdatedVMI("vmi", true), hco.Spec)
+			Expect(blocked).To(BeTrue())
+			Expect(reason).To(ContainSubstring("disabled"))
+		})
+
+		It("should block a non-migratable VMI if the Evict method is not enabled", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{workloadUpdateMethodLiveMigrate}
+
+			reason, blocked := getVMIBlockedReason(newOutdatedVMI("vmi", false), hco.Spec)
+			Expect(blocked).To(BeTrue())
+			Expect(reason).To(ContainSubstring("DisksNotLiveMigratable"))
+			Expect(reason).To(ContainSubstring(workloadUpdateMethodEvict))
+		})
+
+		It("should block a non-migratable VMI with the LiveMigrate eviction strategy", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{workloadUpdateMethodLiveMigrate, workloadUpdateMethodEvict}
+			hco.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyLiveMigrate)
+
+			reason, blocked := getVMIBlockedReason(newOutdatedVMI("vmi", false), hco.Spec)
+			Expect(blocked).To(BeTrue())
+			Expect(reason).To(ContainSubstring("eviction strategy"))
+		})
+
+		It("should not block a non-migratable VMI that can be evicted", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{workloadUpdateMethodLiveMigrate, workloadUpdateMethodEvict}
+			hco.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyLiveMigrate)
+
+			vmi := newOutdatedVMI("vmi", false)
+			vmi.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyNone)
+
+			_, blocked := getVMIBlockedReason(vmi, hco.Spec)
+			Expect(blocked).To(BeFalse())
+		})
+	})
+
+	Context("summarizeOutdatedVMIs", func() {
+		It("should count the VMIs by their update state", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{workloadUpdateMethodLiveMigrate}
+
+			migrating := newOutdatedVMI("migrating", true)
+			migrating.Status.MigrationState = &kubevirtcorev1.VirtualMachineInstanceMigrationState{}
+
+			succeeded := newOutdatedVMI("succeeded", false)
+			succeeded.Status.Phase = kubevirtcorev1.Succeeded
+
+			vmis := []kubevirtcorev1.VirtualMachineInstance{
+				*newOutdatedVMI("pending", true),
+				*migrating,
+				*newOutdatedVMI("blocked-b", false),
+				*newOutdatedVMI("blocked-a", false),
+				*succeeded,
+			}
+
+			status := summarizeOutdatedVMIs(vmis, hco.Spec)
+			Expect(status.PendingVirtualMachineInstances).To(Equal(1))
+			Expect(status.MigratingVirtualMachineInstances).To(Equal(1))
+			Expect(status.BlockedVirtualMachineInstances).To(Equal(2))
+			Expect(status.BlockedVirtualMachines).To(HaveLen(2))
+			Expect(status.BlockedVirtualMachines[0].Name).To(Equal("blocked-a"))
+			Expect(status.BlockedVirtualMachines[1].Name).To(Equal("blocked-b"))
+		})
+
+		It("should limit the number of the listed blocked VMs", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = nil
+
+			var vmis []kubevirtcorev1.VirtualMachineInstance
+			for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+				vmis = append(vmis, *newOutdatedVMI(name, true))
+			}
+
+			status := summarizeOutdatedVMIs(vmis, hco.Spec)
+			Expect(status.BlockedVirtualMachineInstances).To(Equal(12))
+			Expect(status.BlockedVirtualMachines).To(HaveLen(blockedVMsSampleSize))
+		})
+	})
+
+	Context("isWorkloadUpdateStalled", func() {
+		It("should not be stalled if there was a recent progress", func() {
+			strategy := commontestutils.NewHco().Spec.WorkloadUpdateStrategy
+			status := &hcov1beta1.WorkloadUpdateStatus{LastProgressTime: ptr.To(metav1.Now())}
+			Expect(isWorkloadUpdateStalled(status, strategy)).To(BeFalse())
+		})
+
+		It("should be stalled if there was no progress for longer than the threshold", func() {
+			strategy := commontestutils.NewHco().Spec.WorkloadUpdateStrategy
+			strategy.UpdateStallThreshold = &metav1.Duration{Duration: 10 * time.Minute}
+			status := &hcov1beta1.WorkloadUpdateStatus{LastProgressTime: ptr.To(metav1.NewTime(time.Now().Add(-11 * time.Minute)))}
+			Expect(isWorkloadUpdateStalled(status, strategy)).To(BeTrue())
+		})
+
+		It("should not be stalled if the automated workload update is disabled", func() {
+			strategy := commontestutils.NewHco().Spec.WorkloadUpdateStrategy
+			strategy.WorkloadUpdateMethods = nil
+			status := &hcov1beta1.WorkloadUpdateStatus{LastProgressTime: ptr.To(metav1.NewTime(time.Now().Add(-24 * time.Hour)))}
+			Expect(isWorkloadUpdateStalled(status, strategy)).To(BeFalse())
+		})
+	})
+
+	Context("updateWorkloadUpdateStatus", func() {
+		It("should not set the status if there are no outdated VMIs", func() {
+			hco := commontestutils.NewHco()
+			req := commontestutils.NewReq(hco)
+			cl := commontestutils.InitClient([]client.Object{hco, newKubeVirt(hco, 0)})
+			r := &ReconcileHyperConverged{client: cl}
+
+			r.updateWorkloadUpdateStatus(req)
+
+			Expect(hco.Status.WorkloadUpdate).To(BeNil())
+			Expect(req.StatusDirty).To(BeFalse())
+		})
+
+		It("should clear the status when the update is completed", func() {
+			hco := commontestutils.NewHco()
+			hco.Status.WorkloadUpdate = &hcov1beta1.WorkloadUpdateStatus{OutdatedVirtualMachineInstances: 1}
+			req := commontestutils.NewReq(hco)
+			cl := commontestutils.InitClient([]client.Object{hco, newKubeVirt(hco, 0)})
+			r := &ReconcileHyperConverged{client: cl}
+
+			r.updateWorkloadUpdateStatus(req)
+
+			Expect(hco.Status.WorkloadUpdate).To(BeNil())
+			Expect(req.StatusDirty).To(BeTrue())
+		})
+
+		It("should summarize the outdated VMIs in the status and in the metrics", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{workloadUpdateMethodLiveMigrate}
+			req := commontestutils.NewReq(hco)
+			cl := commontestutils.InitClient([]client.Object{
+				hco,
+				newKubeVirt(hco, 2),
+				newOutdatedVMI("pending", true),
+				newOutdatedVMI("blocked", false),
+			})
+			r := &ReconcileHyperConverged{client: cl}
+
+			r.updateWorkloadUpdateStatus(req)
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.WorkloadUpdate).ToNot(BeNil())
+			Expect(hco.Status.WorkloadUpdate.OutdatedVirtualMachineInstances).To(Equal(2))
+			Expect(hco.Status.WorkloadUpdate.PendingVirtualMachineInstances).To(Equal(1))
+			Expect(hco.Status.WorkloadUpdate.BlockedVirtualMachineInstances).To(Equal(1))
+			Expect(hco.Status.WorkloadUpdate.BlockedVirtualMachines).To(HaveLen(1))
+			Expect(hco.Status.WorkloadUpdate.LastProgressTime).ToNot(BeNil())
+
+			Expect(metrics.GetWorkloadUpdateOutdatedVMIs(metrics.WorkloadUpdateStatePending)).To(BeEquivalentTo(1))
+			Expect(metrics.GetWorkloadUpdateOutdatedVMIs(metrics.WorkloadUpdateStateBlocked)).To(BeEquivalentTo(1))
+			Expect(metrics.IsWorkloadUpdateStalled()).To(BeFalse())
+		})
+
+		It("should keep the last progress time if the number of the outdated VMIs was not reduced", func() {
+			lastProgress := metav1.NewTime(time.Now().Add(-2 * time.Hour).Truncate(time.Second))
+
+			hco := commontestutils.NewHco()
+			hco.Status.WorkloadUpdate = &hcov1beta1.WorkloadUpdateStatus{
+				OutdatedVirtualMachineInstances: 1,
+				LastProgressTime:                &lastProgress,
+			}
+			req := commontestutils.NewReq(hco)
+			cl := commontestutils.InitClient([]client.Object{hco, newKubeVirt(hco, 1), newOutdatedVMI("pending", true)})
+			r := &ReconcileHyperConverged{client: cl}
+
+			r.updateWorkloadUpdateStatus(req)
+
+			Expect(hco.Status.WorkloadUpdate.LastProgressTime.Time).To(Equal(lastProgress.Time))
+			Expect(metrics.IsWorkloadUpdateStalled()).To(BeTrue())
+		})
+	})
+})
@@ -28,6 +28,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/reqresolver"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/downloadhost"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -256,7 +257,9 @@ func (r *ReconcileIngressCluster) getDownloadHost(ctx context.Context, clusterIn
 		return component.Name == componentName && component.Namespace == selfNamespace
 	})
 
-	if routeInd >= 0 {
+	if routeInd < 0 {
+		metrics.DeleteCertificateExpiration(metrics.CertificateCLIDownloadsRoute)
+	} else {
 		customHost := clusterIngress.Spec.ComponentRoutes[routeInd].Hostname
 		secretName := clusterIngress.Spec.ComponentRoutes[routeInd].ServingCertKeyPairSecret.Name
 
@@ -307,7 +310,16 @@ func (r *ReconcileIngressCluster) getSecret(ctx context.Context, secretName stri
 				return "", "", err
 			}
 
-			if err = verifyCertificate(crt); err != nil {
+			certificate, err := parseCertificate(crt)
+			if err != nil {
+				lgr.Error(err, "wrong secret: can't parse certificate", "secret name", secretName, "namespace", secretNamespace)
+				return "", "", err
+			}
+
+			// export the expiration time even if the certificate is already expired, to trigger the alert
+			metrics.SetCertificateExpiration(metrics.CertificateCLIDownloadsRoute, certificate.NotAfter)
+
+			if err = verifyCertificate(certificate); err != nil {
 				lgr.Error(err, "wrong secret: can't verify certificate", "secret name", secretName, "namespace", secretNamespace)
 				return "", "", err
 			}
@@ -321,5 +333,6 @@ func (r *ReconcileIngressCluster) getSecret(ctx context.Context, secretName stri
 		}
 	}
 
+	metrics.DeleteCertificateExpiration(metrics.CertificateCLIDownloadsRoute)
 	return "", "", nil
 }
@@ -30,6 +30,7 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/reqresolver"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/downloadhost"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	hcoutils "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -405,6 +406,10 @@ var _ = Describe("IngressClusterController", func() {
 		Expect(host.Key).To(Equal(string(key)))
 
 		Expect(meta.IsStatusConditionFalse(component.Conditions, "Degraded")).To(BeTrueBecause("Degraded condition should be False"))
+
+		certificate, err := parseCertificate(cert)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metrics.GetCertificateExpiration(metrics.CertificateCLIDownloadsRoute)).To(Equal(float64(certificate.NotAfter.Unix())))
 	})
 
 	It("should not modify virt-downloads component, if the secret not found", func(ctx context.Context) {
@@ -7,15 +7,15 @@ import (
 	"time"
 )
 
-func verifyCertificate(customCert []byte) error {
+func parseCertificate(customCert []byte) (*x509.Certificate, error) {
 	block, _ := pem.Decode(customCert)
 	if block == nil {
-		return fmt.Errorf("failed to decode certificate PEM")
-	}
-	certificate, err := x509.ParseCertificate(block.Bytes)
-	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to decode certificate PEM")
 	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func verifyCertificate(certificate *x509.Certificate) error {
 	now := time.Now()
 	if now.After(certificate.NotAfter) {
 		return fmt.Errorf("custom TLS certificate is expired")
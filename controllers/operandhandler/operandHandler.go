@@ -16,7 +16,6 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
-	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/passt"
 	waspagent "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/wasp-agent"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
@@ -46,26 +45,27 @@ type OperandHandler struct {
 	eventEmitter hcoutil.EventEmitter
 }
 
-func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, eventEmitter hcoutil.EventEmitter) *OperandHandler {
+func NewOperandHandler(client client.Client, apiReader client.Reader, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, eventEmitter hcoutil.EventEmitter) *OperandHandler {
 	operandList := []operands.Operand{
 		handlers.NewKvPriorityClassHandler(client, scheme),
 		handlers.NewKubevirtHandler(client, scheme),
+		handlers.NewImportProxyCAConfigMapHandler(client, scheme),
 		handlers.NewCdiHandler(client, scheme),
 		handlers.NewCnaHandler(client, scheme),
+		handlers.NewKubeSecondaryDNSServiceHandler(client, scheme),
 		handlers.NewAAQHandler(client, scheme),
 		handlers.NewMigControllerHandler(client, scheme),
-		passt.NewPasstDaemonSetHandler(client, scheme),
-		passt.NewPasstNetworkAttachmentDefinitionHandler(client, scheme),
 	}
 
+	operandList = append(operandList, handlers.GetNetworkBindingPluginHandlers(client, scheme, ci.IsOpenshift())...)
+	operandList = append(operandList, handlers.GetNetworkPolicyHandlers(client, scheme)...)
+
 	if ci.IsOpenshift() {
 		operandList = append(operandList, []operands.Operand{
 			handlers.NewSspHandler(client, scheme),
 			handlers.NewCliDownloadHandler(client, scheme),
 			handlers.NewCliDownloadsRouteHandler(client, scheme),
 			operands.NewServiceHandler(client, scheme, handlers.NewCliDownloadsService),
-			passt.NewPasstServiceAccountHandler(client, scheme),
-			passt.NewPasstSecurityContextConstraintsHandler(client, scheme),
 			waspagent.NewWaspAgentServiceAccountHandler(client, scheme),
 			waspagent.NewWaspAgentSCCHandler(client, scheme),
 			waspagent.NewWaspAgentDaemonSetHandler(client, scheme),
@@ -78,8 +78,18 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.
 		operandList = append(operandList, operands.NewServiceHandler(client, scheme, handlers.NewKvUIProxySvc))
 	}
 
+	if ci.IsBaselineAdminNetworkPolicyAvailable() {
+		operandList = append(operandList, handlers.NewBaselineAdminNetworkPolicyHandler(client, scheme))
+	}
+
 	if ci.IsManagedByOLM() {
 		operandList = append(operandList, handlers.NewCsvHandler(client))
+	} else if ci.IsCertManagerAvailable() {
+		// with OLM, the webhook certificate is managed by OLM
+		operandList = append(operandList,
+			handlers.NewWebhookCertificateHandler(client, scheme),
+			handlers.NewWebhookCertificateWiringHandler(client, apiReader),
+		)
 	}
 
 	return &OperandHandler{
@@ -240,11 +250,12 @@ func (h *OperandHandler) EnsureDeleted(req *common.HcoRequest) error {
 		handlers.NewConsoleCLIDownload(req.Instance),
 		handlers.NewAAQWithNameOnly(req.Instance),
 		handlers.NewMigControllerWithNameOnly(req.Instance),
-		passt.NewPasstBindingCNINetworkAttachmentDefinition(req.Instance),
-		passt.NewPasstBindingCNISecurityContextConstraints(req.Instance),
 		waspagent.NewWaspAgentSCCWithNameOnly(req.Instance),
+		handlers.NewBaselineAdminNetworkPolicyWithNameOnly(),
 	}
 
+	resources = append(resources, handlers.GetNetworkBindingPluginObjectsToDelete(req.Instance)...)
+
 	resources = append(resources, h.objects...)
 
 	err := h.deleteMultipleResources(tCtx, req, resources)
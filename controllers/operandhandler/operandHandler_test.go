@@ -74,7 +74,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -171,7 +171,7 @@ var _ = Describe("Test operandHandler", func() {
 			eventEmitter := commontestutils.NewEventEmitterMock()
 			ci := commontestutils.ClusterInfoMock{}
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -211,7 +211,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -299,7 +299,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -348,7 +348,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -399,7 +399,7 @@ var _ = Describe("Test operandHandler", func() {
 			fakeError := fmt.Errorf("fake CNA deletion error")
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -448,7 +448,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -494,7 +494,7 @@ var _ = Describe("Test operandHandler", func() {
 			ci := commontestutils.ClusterInfoMock{}
 			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, commontestutils.GetCSV()})
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -549,7 +549,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -18,6 +18,35 @@ func NewCmHandler(Client client.Client, Scheme *runtime.Scheme, required *corev1
 	return NewGenericOperand(Client, Scheme, "ConfigMap", &cmHooks{required: required}, false)
 }
 
+type newCmFunc func(hc *hcov1beta1.HyperConverged) *corev1.ConfigMap
+
+// NewDynamicCmHandler creates a ConfigMap handler that generates the required ConfigMap from the HyperConverged CR,
+// on each reconciliation
+func NewDynamicCmHandler(Client client.Client, Scheme *runtime.Scheme, newCrFunc newCmFunc) *GenericOperand {
+	return NewGenericOperand(Client, Scheme, "ConfigMap", &dynamicCmHooks{newCrFunc: newCrFunc}, false)
+}
+
+type dynamicCmHooks struct {
+	newCrFunc newCmFunc
+}
+
+func (h dynamicCmHooks) GetFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	return h.newCrFunc(hc), nil
+}
+
+func (dynamicCmHooks) GetEmptyCr() client.Object {
+	return &corev1.ConfigMap{}
+}
+
+func (dynamicCmHooks) UpdateCR(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	cm, ok := required.(*corev1.ConfigMap)
+	if !ok {
+		return false, false, errors.New("can't convert to Configmap")
+	}
+
+	return cmHooks{required: cm}.UpdateCR(req, Client, exists, required)
+}
+
 type cmHooks struct {
 	required *corev1.ConfigMap
 }
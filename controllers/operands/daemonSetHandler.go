@@ -32,6 +32,7 @@ func (h *daemonSetHooks) GetFullCr(hc *hcov1beta1.HyperConverged) (client.Object
 
 	if h.cache == nil {
 		h.cache = h.newCrFunc(hc)
+		setImagePullSecrets(hc, &h.cache.Spec.Template.Spec)
 	}
 	return h.cache, nil
 }
@@ -98,7 +99,8 @@ func hasCorrectDaemonSetFields(found *appsv1.DaemonSet, required *appsv1.DaemonS
 		reflect.DeepEqual(found.Spec.Template.Spec.Volumes, required.Spec.Template.Spec.Volumes) &&
 		reflect.DeepEqual(found.Spec.Template.Spec.Affinity, required.Spec.Template.Spec.Affinity) &&
 		reflect.DeepEqual(found.Spec.Template.Spec.NodeSelector, required.Spec.Template.Spec.NodeSelector) &&
-		reflect.DeepEqual(found.Spec.Template.Spec.Tolerations, required.Spec.Template.Spec.Tolerations)
+		reflect.DeepEqual(found.Spec.Template.Spec.Tolerations, required.Spec.Template.Spec.Tolerations) &&
+		reflect.DeepEqual(found.Spec.Template.Spec.ImagePullSecrets, required.Spec.Template.Spec.ImagePullSecrets)
 }
 
 func shouldRecreateDaemonSet(found, required *appsv1.DaemonSet) bool {
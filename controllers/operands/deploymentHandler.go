@@ -28,10 +28,19 @@ type deploymentHooks struct {
 }
 
 func newDeploymentHooks(deploymentGenerator newDeploymentFunc, hc *hcov1beta1.HyperConverged) *deploymentHooks {
-	return &deploymentHooks{
-		cache:               deploymentGenerator(hc),
+	h := &deploymentHooks{
 		deploymentGenerator: deploymentGenerator,
 	}
+	h.cache = h.newDeployment(hc)
+
+	return h
+}
+
+func (h *deploymentHooks) newDeployment(hc *hcov1beta1.HyperConverged) *appsv1.Deployment {
+	deployment := h.deploymentGenerator(hc)
+	setImagePullSecrets(hc, &deployment.Spec.Template.Spec)
+
+	return deployment
 }
 
 func (h *deploymentHooks) Reset() {
@@ -46,7 +55,7 @@ func (h *deploymentHooks) GetFullCr(hc *hcov1beta1.HyperConverged) (client.Objec
 	defer h.Unlock()
 
 	if h.cache == nil {
-		h.cache = h.deploymentGenerator(hc)
+		h.cache = h.newDeployment(hc)
 	}
 
 	return h.cache, nil
@@ -103,7 +112,9 @@ func hasCorrectDeploymentFields(found *appsv1.Deployment, required *appsv1.Deplo
 		reflect.DeepEqual(found.Spec.Template.Spec.PriorityClassName, required.Spec.Template.Spec.PriorityClassName) &&
 		reflect.DeepEqual(found.Spec.Template.Spec.Affinity, required.Spec.Template.Spec.Affinity) &&
 		reflect.DeepEqual(found.Spec.Template.Spec.NodeSelector, required.Spec.Template.Spec.NodeSelector) &&
-		reflect.DeepEqual(found.Spec.Template.Spec.Tolerations, required.Spec.Template.Spec.Tolerations)
+		reflect.DeepEqual(found.Spec.Template.Spec.Tolerations, required.Spec.Template.Spec.Tolerations) &&
+		reflect.DeepEqual(found.Spec.Template.Spec.ImagePullSecrets, required.Spec.Template.Spec.ImagePullSecrets) &&
+		found.Spec.Template.Annotations[util.ConfigHashAnnotation] == required.Spec.Template.Annotations[util.ConfigHashAnnotation]
 }
 
 func shouldRecreate(found, required *appsv1.Deployment) bool {
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -173,6 +174,33 @@ var _ = Describe("Deployment Handler", func() {
 			Expect(foundResource.Labels).To(HaveKeyWithValue(userLabelKey, userLabelValue))
 		})
 
+		It("should set the image pull secrets in the Deployment pods", func() {
+			cl := commontestutils.InitClient([]client.Object{hco, NewExpectedDeployment(hco)})
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment, hco)
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+
+			hco.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "registry-secret"}}
+			handler.Reset()
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			foundResource := &appsv1.Deployment{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expectedDeployment), foundResource)).To(Succeed())
+			Expect(foundResource.Spec.Template.Spec.ImagePullSecrets).To(Equal(hco.Spec.ImagePullSecrets))
+
+			hco.Spec.ImagePullSecrets = []corev1.LocalObjectReference{}
+			handler.Reset()
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expectedDeployment), foundResource)).To(Succeed())
+			Expect(foundResource.Spec.Template.Spec.ImagePullSecrets).To(BeNil())
+		})
 	})
 
 })
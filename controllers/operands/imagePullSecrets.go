@@ -0,0 +1,25 @@
+package operands
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// GetImagePullSecrets returns a copy of spec.imagePullSecrets, or nil if it is empty; the API server drops an empty
+// list, so an empty list would never match the existing object.
+func GetImagePullSecrets(hc *hcov1beta1.HyperConverged) []corev1.LocalObjectReference {
+	if len(hc.Spec.ImagePullSecrets) == 0 {
+		return nil
+	}
+
+	return slices.Clone(hc.Spec.ImagePullSecrets)
+}
+
+// setImagePullSecrets sets spec.imagePullSecrets in the pods of the Deployments and the DaemonSets that HCO deploys;
+// the ServiceAccounts that HCO deploys get them in their own imagePullSecrets field
+func setImagePullSecrets(hc *hcov1beta1.HyperConverged, podSpec *corev1.PodSpec) {
+	podSpec.ImagePullSecrets = GetImagePullSecrets(hc)
+}
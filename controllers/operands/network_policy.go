@@ -13,22 +13,24 @@ import (
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
-func NewNetworkPolicyHandler(Client client.Client, Scheme *runtime.Scheme, required *networkingv1.NetworkPolicy) *GenericOperand {
-	return NewGenericOperand(Client, Scheme, "NetworkPolicy", newNetworkPolicyHook(required), false)
+func NewNetworkPolicyHandler(Client client.Client, Scheme *runtime.Scheme, newCrFunc newNetworkPolicyFunc) *GenericOperand {
+	return NewGenericOperand(Client, Scheme, "NetworkPolicy", newNetworkPolicyHook(newCrFunc), false)
 }
 
+type newNetworkPolicyFunc func(hc *hcov1beta1.HyperConverged) *networkingv1.NetworkPolicy
+
 type networkPolicyHook struct {
-	required *networkingv1.NetworkPolicy
+	newCrFunc newNetworkPolicyFunc
 }
 
-func newNetworkPolicyHook(required *networkingv1.NetworkPolicy) *networkPolicyHook {
+func newNetworkPolicyHook(newCrFunc newNetworkPolicyFunc) *networkPolicyHook {
 	return &networkPolicyHook{
-		required: required,
+		newCrFunc: newCrFunc,
 	}
 }
 
-func (nph *networkPolicyHook) GetFullCr(_ *hcov1beta1.HyperConverged) (client.Object, error) {
-	return nph.required.DeepCopy(), nil
+func (nph *networkPolicyHook) GetFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	return nph.newCrFunc(hc), nil
 }
 
 func (nph *networkPolicyHook) GetEmptyCr() client.Object {
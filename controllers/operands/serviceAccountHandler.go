@@ -2,6 +2,8 @@ package operands
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -12,6 +14,11 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
+// imagePullSecretsAnnotation records the names of the image pull secrets that HCO added to a ServiceAccount. Only
+// these secrets are removed when they are removed from the HyperConverged CR; the other image pull secrets of the
+// ServiceAccount, e.g. the dockercfg secret that OpenShift injects, are kept.
+const imagePullSecretsAnnotation = util.HCOAnnotationPrefix + "imagePullSecrets"
+
 type newSvcAccountFunc func(hc *hcov1beta1.HyperConverged) *corev1.ServiceAccount
 
 func NewServiceAccountHandler(Client client.Client, Scheme *runtime.Scheme, newCrFunc newSvcAccountFunc) *GenericOperand {
@@ -23,7 +30,11 @@ type serviceAccountHooks struct {
 }
 
 func (h serviceAccountHooks) GetFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
-	return h.newCrFunc(hc), nil
+	sa := h.newCrFunc(hc)
+	sa.ImagePullSecrets = GetImagePullSecrets(hc)
+	setOwnedImagePullSecrets(sa, sa.ImagePullSecrets)
+
+	return sa, nil
 }
 
 func (serviceAccountHooks) GetEmptyCr() client.Object {
@@ -47,6 +58,8 @@ func updateServiceAccount(req *common.HcoRequest, Client client.Client, exists r
 			req.Logger.Info("Reconciling an externally updated ServiceAccount's Spec to its opinionated values")
 		}
 		util.MergeLabels(&serviceAccount.ObjectMeta, &found.ObjectMeta)
+		found.ImagePullSecrets = mergeImagePullSecrets(found.ImagePullSecrets, serviceAccount.ImagePullSecrets, getOwnedImagePullSecrets(found))
+		setOwnedImagePullSecrets(found, serviceAccount.ImagePullSecrets)
 		err := Client.Update(req.Ctx, found)
 		if err != nil {
 			return false, false, err
@@ -56,6 +69,73 @@ func updateServiceAccount(req *common.HcoRequest, Client client.Client, exists r
 	return false, false, nil
 }
 
+// hasServiceAccountRightFields only compares the image pull secrets that HCO owns; the ServiceAccount may hold other
+// image pull secrets, that were not added by HCO
 func hasServiceAccountRightFields(found *corev1.ServiceAccount, required *corev1.ServiceAccount) bool {
-	return util.CompareLabels(required, found)
+	if !util.CompareLabels(required, found) {
+		return false
+	}
+
+	requiredNames := getSecretNames(required.ImagePullSecrets)
+	if !slices.Equal(getOwnedImagePullSecrets(found), requiredNames) {
+		return false
+	}
+
+	foundNames := getSecretNames(found.ImagePullSecrets)
+	for _, name := range requiredNames {
+		if !slices.Contains(foundNames, name) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeImagePullSecrets keeps the image pull secrets that HCO does not own, drops the ones that HCO added earlier, and
+// adds the required ones
+func mergeImagePullSecrets(existing, required []corev1.LocalObjectReference, owned []string) []corev1.LocalObjectReference {
+	requiredNames := getSecretNames(required)
+
+	var merged []corev1.LocalObjectReference
+	for _, secret := range existing {
+		if !slices.Contains(owned, secret.Name) && !slices.Contains(requiredNames, secret.Name) {
+			merged = append(merged, secret)
+		}
+	}
+
+	return append(merged, required...)
+}
+
+func getOwnedImagePullSecrets(sa *corev1.ServiceAccount) []string {
+	owned, ok := sa.Annotations[imagePullSecretsAnnotation]
+	if !ok || owned == "" {
+		return nil
+	}
+
+	return strings.Split(owned, ",")
+}
+
+func setOwnedImagePullSecrets(sa *corev1.ServiceAccount, secrets []corev1.LocalObjectReference) {
+	if len(secrets) == 0 {
+		delete(sa.Annotations, imagePullSecretsAnnotation)
+		return
+	}
+
+	if sa.Annotations == nil {
+		sa.Annotations = make(map[string]string)
+	}
+	sa.Annotations[imagePullSecretsAnnotation] = strings.Join(getSecretNames(secrets), ",")
+}
+
+func getSecretNames(secrets []corev1.LocalObjectReference) []string {
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		names = append(names, secret.Name)
+	}
+
+	return names
 }
@@ -49,6 +49,7 @@ func updateService(req *common.HcoRequest, Client client.Client, exists runtime.
 	if !ok1 || !ok2 {
 		return false, false, errors.New("can't convert to Service")
 	}
+	keepAllocatedNodePorts(found, service)
 	if !HasServiceRightFields(found, service) {
 		if req.HCOTriggered {
 			req.Logger.Info("Updating existing Service Spec to new opinionated values")
@@ -74,6 +75,28 @@ func updateService(req *common.HcoRequest, Client client.Client, exists runtime.
 // never returns true.
 func HasServiceRightFields(found *corev1.Service, required *corev1.Service) bool {
 	return util.CompareLabels(required, found) &&
+		(required.Spec.Type == "" || required.Spec.Type == found.Spec.Type) &&
 		reflect.DeepEqual(required.Spec.Selector, found.Spec.Selector) &&
 		reflect.DeepEqual(required.Spec.Ports, found.Spec.Ports)
 }
+
+// keepAllocatedNodePorts copies the node ports that the cluster allocated to the found Service, to the required ports
+// that don't set a node port, so they are not considered as modified.
+func keepAllocatedNodePorts(found *corev1.Service, required *corev1.Service) {
+	if required.Spec.Type != corev1.ServiceTypeNodePort && required.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return
+	}
+
+	for i, port := range required.Spec.Ports {
+		if port.NodePort != 0 {
+			continue
+		}
+
+		for _, foundPort := range found.Spec.Ports {
+			if foundPort.Name == port.Name && foundPort.Protocol == port.Protocol {
+				required.Spec.Ports[i].NodePort = foundPort.NodePort
+				break
+			}
+		}
+	}
+}
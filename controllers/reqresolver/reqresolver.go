@@ -12,6 +12,7 @@ import (
 const (
 	secondaryCRPrefix = "hco-controlled-cr-"
 	apiServerCRPrefix = "api-server-cr-"
+	proxyCRPrefix     = "proxy-cr-"
 	ingressCRPrefix   = "ingress-cr-"
 	nodePrefix        = "node-"
 )
@@ -29,6 +30,8 @@ var (
 
 	apiServerCRPlaceholder types.NamespacedName
 
+	proxyCRPlaceholder types.NamespacedName
+
 	ingressCRPlaceholder types.NamespacedName
 
 	nodePlaceholder types.NamespacedName
@@ -46,6 +49,11 @@ func ResolveReconcileRequest(logger logr.Logger, originalRequest reconcile.Reque
 		// consider a change in APIServerCr like a change in HCO
 		triggeredByHyperConverged = true
 
+	case proxyCRPlaceholder:
+		logger.Info("The reconciliation got triggered by Proxy CR")
+		// consider a change in the cluster-wide proxy like a change in HCO
+		triggeredByHyperConverged = true
+
 	case ingressCRPlaceholder:
 		logger.Info("The reconciliation got triggered by Ingress CR")
 		// consider a change in Ingress like a change in HCO
@@ -89,6 +97,12 @@ func GetAPIServerCRRequest() reconcile.Request {
 	}
 }
 
+func GetProxyCRRequest() reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: proxyCRPlaceholder,
+	}
+}
+
 func GetIngressCRResource() reconcile.Request {
 	return reconcile.Request{
 		NamespacedName: ingressCRPlaceholder,
@@ -109,6 +123,10 @@ func IsTriggeredByAPIServerCR(request reconcile.Request) bool {
 	return request.NamespacedName == apiServerCRPlaceholder
 }
 
+func IsTriggeredByProxyCR(request reconcile.Request) bool {
+	return request.NamespacedName == proxyCRPlaceholder
+}
+
 func GeneratePlaceHolders() {
 	randomConstSuffix = uuid.New().String()
 
@@ -128,6 +146,11 @@ func GeneratePlaceHolders() {
 		Namespace: ns,
 	}
 
+	proxyCRPlaceholder = types.NamespacedName{
+		Name:      proxyCRPrefix + randomConstSuffix,
+		Namespace: ns,
+	}
+
 	ingressCRPlaceholder = types.NamespacedName{
 		Name:      ingressCRPrefix + randomConstSuffix,
 		Namespace: ns,
@@ -71,6 +71,15 @@ var _ = Describe("test ResolveReconcileRequest", func() {
 		Expect(triggeredByHC).To(BeTrueBecause("should recognized as triggered by the HyperConverged CR"))
 	})
 
+	It("should return HC req and true for request triggered by Proxy", func() {
+		expected := reconcile.Request{
+			NamespacedName: reqresolver.GetHyperConvergedNamespacedName(),
+		}
+		requestAfter, triggeredByHC := reqresolver.ResolveReconcileRequest(GinkgoLogr, reqresolver.GetProxyCRRequest())
+		Expect(requestAfter).To(Equal(expected))
+		Expect(triggeredByHC).To(BeTrueBecause("should recognized as triggered by the HyperConverged CR"))
+	})
+
 	It("should return HC req and true for request triggered by Ingress", func() {
 		expected := reconcile.Request{
 			NamespacedName: reqresolver.GetHyperConvergedNamespacedName(),
@@ -106,6 +115,15 @@ var _ = Describe("Check generated requests", func() {
 		Expect(reqresolver.IsTriggeredByAPIServerCR(req)).To(BeTrueBecause("should be recognized as triggered by APIServer CR"))
 	})
 
+	It("test GetProxyCRRequest", func() {
+		req := reqresolver.GetProxyCRRequest()
+		Expect(req.NamespacedName.Namespace).To(Equal(namespace))
+		Expect(req.NamespacedName.Name).To(HavePrefix("proxy-cr-"))
+		Expect(reqresolver.IsTriggeredByHyperConverged(req.NamespacedName)).To(BeFalseBecause("should not be recognized as triggered by HyperConverged CR"))
+		Expect(reqresolver.IsTriggeredByAPIServerCR(req)).To(BeFalseBecause("should not be recognized as triggered by APIServer CR"))
+		Expect(reqresolver.IsTriggeredByProxyCR(req)).To(BeTrueBecause("should be recognized as triggered by Proxy CR"))
+	})
+
 	It("test GetSecondaryCRRequest", func() {
 		req := reqresolver.GetSecondaryCRRequest()
 		Expect(req.NamespacedName.Namespace).To(Equal(namespace))
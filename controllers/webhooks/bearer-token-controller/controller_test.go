@@ -4,11 +4,14 @@ import (
 	"context"
 	"maps"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/golang-jwt/jwt/v5"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -21,6 +24,7 @@ import (
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources"
 	fakeownresources "github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources/fake"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -157,7 +161,6 @@ var _ = Describe("Controller setup and reconcile", func() {
 			Expect(cl.Get(ctx, client.ObjectKey{Namespace: nsName, Name: secretName}, sec)).To(Succeed())
 			Expect(sec.StringData).To(HaveKey("token"))
 
-			origToken := sec.StringData["token"]
 			sec.StringData["token"] = "some-wrong-token"
 			Expect(cl.Update(ctx, sec)).To(Succeed())
 
@@ -174,7 +177,7 @@ var _ = Describe("Controller setup and reconcile", func() {
 			newSec = &corev1.Secret{}
 			Expect(cl.Get(ctx, client.ObjectKey{Namespace: nsName, Name: secretName}, newSec)).To(Succeed())
 			Expect(newSec.StringData).To(HaveKey("token"))
-			Expect(newSec.StringData["token"]).To(Equal(origToken))
+			Expect(newSec.StringData["token"]).To(beValidToken())
 
 			newSM := &monitoringv1.ServiceMonitor{}
 			Expect(cl.Get(ctx, client.ObjectKey{Namespace: nsName, Name: serviceName}, newSM)).To(MatchError(apierrors.IsNotFound, "not found error"))
@@ -300,13 +303,7 @@ var _ = Describe("Controller setup and reconcile", func() {
 			})
 
 			When("token was changed", func() {
-				var token string
-
 				BeforeEach(func() {
-					var err error
-					token, err = authorization.CreateToken()
-					Expect(err).ToNot(HaveOccurred())
-
 					secret = newSecret(nsName, ownresources.GetDeploymentRef(), "something-else")
 					secret.Data = map[string][]byte{"token": []byte("something-else")}
 				})
@@ -325,7 +322,7 @@ var _ = Describe("Controller setup and reconcile", func() {
 
 						found := &corev1.Secret{}
 						Expect(cl.Get(ctx, client.ObjectKeyFromObject(secret), found)).To(Succeed())
-						Expect(found.StringData).To(HaveKeyWithValue("token", token))
+						Expect(found.StringData).To(HaveKeyWithValue("token", beValidToken()))
 						Expect(found.Labels).To(HaveKeyWithValue(hcoutil.AppLabelManagedBy, hcoutil.OperatorName))
 					})
 				})
@@ -344,7 +341,7 @@ var _ = Describe("Controller setup and reconcile", func() {
 
 						found := &corev1.Secret{}
 						Expect(cl.Get(ctx, client.ObjectKeyFromObject(secret), found)).To(Succeed())
-						Expect(found.StringData).To(HaveKeyWithValue("token", token))
+						Expect(found.StringData).To(HaveKeyWithValue("token", beValidToken()))
 						Expect(found.Labels).To(HaveKeyWithValue(hcoutil.AppLabelManagedBy, hcoutil.OperatorName))
 					})
 				})
@@ -365,7 +362,7 @@ var _ = Describe("Controller setup and reconcile", func() {
 
 						found := &corev1.Secret{}
 						Expect(cl.Get(ctx, client.ObjectKeyFromObject(secret), found)).To(Succeed())
-						Expect(found.StringData).To(HaveKeyWithValue("token", token))
+						Expect(found.StringData).To(HaveKeyWithValue("token", beValidToken()))
 						Expect(found.Labels).To(Equal(origLabels))
 					})
 				})
@@ -387,7 +384,7 @@ var _ = Describe("Controller setup and reconcile", func() {
 
 						found := &corev1.Secret{}
 						Expect(cl.Get(ctx, client.ObjectKeyFromObject(secret), found)).To(Succeed())
-						Expect(found.StringData).To(HaveKeyWithValue("token", token))
+						Expect(found.StringData).To(HaveKeyWithValue("token", beValidToken()))
 						for k, v := range origLabels {
 							Expect(found.Labels).To(HaveKeyWithValue(k, v))
 						}
@@ -412,7 +409,7 @@ var _ = Describe("Controller setup and reconcile", func() {
 
 						found := &corev1.Secret{}
 						Expect(cl.Get(ctx, client.ObjectKeyFromObject(secret), found)).To(Succeed())
-						Expect(found.StringData).To(HaveKeyWithValue("token", token))
+						Expect(found.StringData).To(HaveKeyWithValue("token", beValidToken()))
 
 						Expect(found.Labels).To(HaveKeyWithValue(hcoutil.AppLabelManagedBy, hcoutil.OperatorName))
 						Expect(found.Labels).To(HaveKeyWithValue("custom-label1", "custom-label1"))
@@ -421,5 +418,73 @@ var _ = Describe("Controller setup and reconcile", func() {
 				})
 			})
 		})
+
+		Context("token rotation", func() {
+			const testSecretKey = "test-secret-key"
+
+			var expiringToken string
+
+			BeforeEach(func() {
+				keyPath := filepath.Join(GinkgoT().TempDir(), "token")
+				Expect(os.WriteFile(keyPath, []byte(testSecretKey), 0600)).To(Succeed())
+				Expect(os.Setenv(authorization.TokenPathEnvVar, keyPath)).To(Succeed())
+				authorization.RefreshSecretKey()
+
+				issuedAt := time.Now().Add(-23 * time.Hour)
+				var err error
+				expiringToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+					Issuer:    authorization.TokenIssuer,
+					Audience:  jwt.ClaimStrings{authorization.TokenAudience},
+					IssuedAt:  jwt.NewNumericDate(issuedAt),
+					ExpiresAt: jwt.NewNumericDate(issuedAt.Add(authorization.DefaultTokenLifetime)),
+				}).SignedString([]byte(testSecretKey))
+				Expect(err).ToNot(HaveOccurred())
+
+				secret = newSecret(nsName, ownresources.GetDeploymentRef(), expiringToken)
+				secret.Data = map[string][]byte{"token": []byte(expiringToken)}
+
+				DeferCleanup(func() {
+					secret = nil
+					_ = os.Unsetenv(authorization.TokenPathEnvVar)
+					authorization.RefreshSecretKey()
+				})
+			})
+
+			It("should rotate the token before it expires", func(ctx context.Context) {
+				ctx = logr.NewContext(ctx, GinkgoLogr)
+				rotations, err := metrics.GetMetricsTokenRotations(secretName)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(authorization.ValidateToken(expiringToken)).To(BeTrue())
+				Expect(authorization.ShouldRotateToken(expiringToken)).To(BeTrue())
+
+				res, err := r.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.RequeueAfter).To(Equal(requeueDurationForNextRequest))
+
+				found := &corev1.Secret{}
+				Expect(cl.Get(ctx, client.ObjectKeyFromObject(secret), found)).To(Succeed())
+				newToken := found.StringData["token"]
+				Expect(newToken).ToNot(Equal(expiringToken))
+				Expect(newToken).To(beValidToken())
+				Expect(authorization.ShouldRotateToken(newToken)).To(BeFalse())
+
+				Expect(metrics.GetMetricsTokenRotations(secretName)).To(Equal(rotations + 1))
+				expiration, err := authorization.GetTokenExpiration(newToken)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metrics.GetMetricsTokenExpiration(secretName)).To(BeEquivalentTo(expiration.Unix()))
+
+				By("the old token should still be accepted, until Prometheus reloads the new one")
+				Expect(expiringToken).To(beValidToken())
+			})
+		})
 	})
 })
+
+// beValidToken succeeds if the actual value is a valid bearer token
+func beValidToken() types.GomegaMatcher {
+	return WithTransform(func(token string) bool {
+		valid, err := authorization.ValidateToken(token)
+		return err == nil && valid
+	}, BeTrue())
+}
@@ -2,7 +2,6 @@ package bearer_token_controller
 
 import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -23,14 +22,6 @@ func newServiceMonitor(namespace string, owner metav1.OwnerReference) *monitorin
 			{
 				Port:   alerts.OperatorPortName,
 				Scheme: "https",
-				Authorization: &monitoringv1.SafeAuthorization{
-					Credentials: &corev1.SecretKeySelector{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: secretName,
-						},
-						Key: "token",
-					},
-				},
 				TLSConfig: &monitoringv1.TLSConfig{
 					SafeTLSConfig: monitoringv1.SafeTLSConfig{
 						InsecureSkipVerify: ptr.To(true),
@@ -40,6 +31,8 @@ func newServiceMonitor(namespace string, owner metav1.OwnerReference) *monitorin
 		},
 	}
 
+	alerts.SetEndpointAuthorization(&spec.Endpoints[0], secretName)
+
 	return &monitoringv1.ServiceMonitor{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: monitoringv1.SchemeGroupVersion.String(),
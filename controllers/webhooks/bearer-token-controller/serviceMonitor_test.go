@@ -7,6 +7,7 @@ import (
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/alerts"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
 )
 
 var _ = Describe("NewServiceMonitor", func() {
@@ -25,4 +26,16 @@ var _ = Describe("NewServiceMonitor", func() {
 		Expect(ep.Authorization.Credentials.Key).To(Equal("token"))
 		Expect(*ep.TLSConfig.InsecureSkipVerify).To(BeTrue())
 	})
+
+	It("should use the Prometheus ServiceAccount token in the kubernetes authorization mode", func() {
+		Expect(authorization.SetMetricsAuthMode(authorization.MetricsAuthModeKubernetes)).To(Succeed())
+		DeferCleanup(authorization.SetMetricsAuthMode, authorization.MetricsAuthModeBearerToken)
+
+		sm := newServiceMonitor(commontestutils.Namespace, metav1.OwnerReference{Name: "o"})
+
+		Expect(sm.Spec.Endpoints).To(HaveLen(1))
+		ep := sm.Spec.Endpoints[0]
+		Expect(ep.Authorization).To(BeNil())
+		Expect(ep.BearerTokenFile).To(Equal("/var/run/secrets/kubernetes.io/serviceaccount/token")) //nolint:staticcheck
+	})
 })
@@ -4,6 +4,8 @@ import (
 	"crypto/rand"
 	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
@@ -11,19 +13,44 @@ import (
 const (
 	TokenPathEnvVar = "KUBERNETES_SERVICE_TOKEN_PATH"
 
+	// TokenLifetimeEnvVar is the environment variable that holds the lifetime of the metrics bearer token, in
+	// golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	TokenLifetimeEnvVar = "METRICS_TOKEN_LIFETIME"
+
+	DefaultTokenLifetime = 24 * time.Hour
+	MinTokenLifetime     = 30 * time.Minute
+
+	TokenIssuer   = "kubevirt-hyperconverged"
+	TokenAudience = "kubevirt-hyperconverged-metrics"
+
 	defaultTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 )
 
-var secretKey []byte
+var (
+	keysLock sync.RWMutex
+	// secretKey is the key that is used to sign new tokens
+	secretKey []byte
+	// previousSecretKey is the key that was in use before the last rotation. Tokens that were signed by it, are still
+	// accepted until previousSecretKeyExpiration, so the scrapes won't fail until Prometheus reloads the new token.
+	previousSecretKey           []byte
+	previousSecretKeyExpiration time.Time
+)
 
+// CreateToken creates a new signed token, that expires after the token lifetime
 func CreateToken() (string, error) {
-	token := jwt.New(jwt.SigningMethodHS256)
-
 	key, err := getSecretKey()
 	if err != nil {
 		return "", fmt.Errorf("error getting secret key: %v", err)
 	}
 
+	issuedAt := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Issuer:    TokenIssuer,
+		Audience:  jwt.ClaimStrings{TokenAudience},
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(issuedAt.Add(GetTokenLifetime())),
+	})
+
 	tokenString, err := token.SignedString(key)
 	if err != nil {
 		return "", fmt.Errorf("error signing token: %v", err)
@@ -32,10 +59,10 @@ func CreateToken() (string, error) {
 	return tokenString, nil
 }
 
+// ValidateToken validates the token signature and claims. Tokens that were signed by the previous key, are accepted
+// during the rotation grace period.
 func ValidateToken(tokenString string) (bool, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
-		return getSecretKey()
-	})
+	token, err := parseToken(tokenString)
 	if err != nil {
 		return false, fmt.Errorf("error parsing token: %v", err)
 	}
@@ -43,18 +70,120 @@ func ValidateToken(tokenString string) (bool, error) {
 	return token.Valid, nil
 }
 
+// ShouldRotateToken returns true if the token is not valid, or if it expires within the renewal period.
+func ShouldRotateToken(tokenString string) bool {
+	token, err := parseToken(tokenString)
+	if err != nil || !token.Valid {
+		return true
+	}
+
+	exp, err := token.Claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return true
+	}
+
+	return time.Until(exp.Time) <= GetTokenRenewBefore()
+}
+
+// GetTokenExpiration returns the expiration time of the token, without validating it
+func GetTokenExpiration(tokenString string) (time.Time, error) {
+	claims := jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("error parsing token: %v", err)
+	}
+
+	if claims.ExpiresAt == nil {
+		return time.Time{}, fmt.Errorf("the token has no expiration time")
+	}
+
+	return claims.ExpiresAt.Time, nil
+}
+
+// GetTokenLifetime returns the lifetime of new tokens, from the TokenLifetimeEnvVar environment variable. If the
+// environment variable is not set or is not valid, the default lifetime is used. The lifetime can't be shorter than
+// MinTokenLifetime.
+func GetTokenLifetime() time.Duration {
+	lifetimeStr, found := os.LookupEnv(TokenLifetimeEnvVar)
+	if !found {
+		return DefaultTokenLifetime
+	}
+
+	lifetime, err := time.ParseDuration(lifetimeStr)
+	if err != nil {
+		return DefaultTokenLifetime
+	}
+
+	return max(lifetime, MinTokenLifetime)
+}
+
+// GetTokenRenewBefore returns how long before the token expiration, it should be rotated. This is also the grace
+// period, during which tokens that were signed by the previous key are still accepted.
+func GetTokenRenewBefore() time.Duration {
+	return GetTokenLifetime() / 4
+}
+
+// ReloadSecretKey drops the cached signing key, so the next token is signed by a key that is read again from the
+// ServiceAccount token file. The kubelet refreshes this file periodically; if it was not refreshed since the key was
+// read, the new key is identical to the previous one. If the file is not available, a new random key is generated.
+// Tokens that were signed by the previous key are still accepted for the renewal period.
+func ReloadSecretKey() {
+	keysLock.Lock()
+	defer keysLock.Unlock()
+
+	if secretKey != nil {
+		previousSecretKey = secretKey
+		previousSecretKeyExpiration = time.Now().Add(GetTokenRenewBefore())
+	}
+
+	secretKey = nil
+}
+
+// RefreshSecretKey drops both the current and the previous keys, with no grace period
 func RefreshSecretKey() {
+	keysLock.Lock()
+	defer keysLock.Unlock()
+
 	secretKey = nil
+	previousSecretKey = nil
+	previousSecretKeyExpiration = time.Time{}
+}
+
+func parseToken(tokenString string) (*jwt.Token, error) {
+	return jwt.Parse(tokenString, getVerificationKeys,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(TokenIssuer),
+		jwt.WithAudience(TokenAudience),
+		jwt.WithIssuedAt(),
+		jwt.WithExpirationRequired(),
+	)
+}
+
+func getVerificationKeys(_ *jwt.Token) (any, error) {
+	key, err := getSecretKey()
+	if err != nil {
+		return nil, err
+	}
+
+	keysLock.RLock()
+	defer keysLock.RUnlock()
+
+	if previousSecretKey == nil || time.Now().After(previousSecretKeyExpiration) {
+		return key, nil
+	}
+
+	return jwt.VerificationKeySet{Keys: []jwt.VerificationKey{key, previousSecretKey}}, nil
 }
 
 func getSecretKey() ([]byte, error) {
+	keysLock.Lock()
+	defer keysLock.Unlock()
+
 	// if secretKey is already available, return it
 	if secretKey != nil {
 		return secretKey, nil
 	}
 
 	var err error
-
 	// get ServiceAccount token from file
 	secretKey, err = getServiceAccountToken()
 	if err != nil {
@@ -81,7 +210,6 @@ func getServiceAccountToken() ([]byte, error) {
 
 func generateInMemorySecretKey() ([]byte, error) {
 	tokenBytes := make([]byte, 32)
-
 	_, err := rand.Read(tokenBytes)
 	if err != nil {
 		return nil, fmt.Errorf("error generating in-memory token: %v", err)
@@ -2,7 +2,9 @@ package authorization_test
 
 import (
 	"os"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -59,6 +61,177 @@ var _ = Describe("BearerToken", func() {
 		})
 	})
 
+	Context("token claims", func() {
+		const testSecretKey = "test-secret-key"
+
+		signToken := func(claims jwt.Claims) string {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecretKey))
+			Expect(err).ToNot(HaveOccurred())
+			return token
+		}
+
+		validClaims := func(issuedAt time.Time) jwt.RegisteredClaims {
+			return jwt.RegisteredClaims{
+				Issuer:    authorization.TokenIssuer,
+				Audience:  jwt.ClaimStrings{authorization.TokenAudience},
+				IssuedAt:  jwt.NewNumericDate(issuedAt),
+				ExpiresAt: jwt.NewNumericDate(issuedAt.Add(authorization.DefaultTokenLifetime)),
+			}
+		}
+
+		It("should create a token with the issuer, audience, issue time and expiration time claims", func() {
+			token, err := authorization.CreateToken()
+			Expect(err).ToNot(HaveOccurred())
+
+			claims := jwt.RegisteredClaims{}
+			_, err = jwt.ParseWithClaims(token, &claims, func(_ *jwt.Token) (any, error) {
+				return []byte(testSecretKey), nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(claims.Issuer).To(Equal(authorization.TokenIssuer))
+			Expect(claims.Audience).To(ConsistOf(authorization.TokenAudience))
+			Expect(claims.IssuedAt).ToNot(BeNil())
+			Expect(claims.ExpiresAt).ToNot(BeNil())
+			Expect(claims.ExpiresAt.Sub(claims.IssuedAt.Time)).To(Equal(authorization.DefaultTokenLifetime))
+
+			Expect(authorization.ShouldRotateToken(token)).To(BeFalse())
+		})
+
+		It("should use the configured token lifetime", func() {
+			GinkgoT().Setenv(authorization.TokenLifetimeEnvVar, "2h")
+			Expect(authorization.GetTokenLifetime()).To(Equal(2 * time.Hour))
+			Expect(authorization.GetTokenRenewBefore()).To(Equal(30 * time.Minute))
+
+			token, err := authorization.CreateToken()
+			Expect(err).ToNot(HaveOccurred())
+
+			expiration, err := authorization.GetTokenExpiration(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expiration).To(BeTemporally("~", time.Now().Add(2*time.Hour), 2*time.Second))
+		})
+
+		DescribeTable("should fall back to a valid token lifetime", func(lifetime string, expected time.Duration) {
+			GinkgoT().Setenv(authorization.TokenLifetimeEnvVar, lifetime)
+			Expect(authorization.GetTokenLifetime()).To(Equal(expected))
+		},
+			Entry("not a duration", "not-a-duration", authorization.DefaultTokenLifetime),
+			Entry("too short", "1m", authorization.MinTokenLifetime),
+		)
+
+		It("should accept a valid token, that was not created by CreateToken", func() {
+			token := signToken(validClaims(time.Now()))
+			Expect(authorization.ValidateToken(token)).To(BeTrue())
+		})
+
+		It("should reject an expired token", func() {
+			token := signToken(validClaims(time.Now().Add(-25 * time.Hour)))
+			valid, err := authorization.ValidateToken(token)
+			Expect(err).To(MatchError(ContainSubstring("expired")))
+			Expect(valid).To(BeFalse())
+			Expect(authorization.ShouldRotateToken(token)).To(BeTrue())
+		})
+
+		It("should reject a token with no expiration time", func() {
+			claims := validClaims(time.Now())
+			claims.ExpiresAt = nil
+			token := signToken(claims)
+
+			valid, err := authorization.ValidateToken(token)
+			Expect(err).To(HaveOccurred())
+			Expect(valid).To(BeFalse())
+
+			_, err = authorization.GetTokenExpiration(token)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject a token with a wrong audience", func() {
+			claims := validClaims(time.Now())
+			claims.Audience = jwt.ClaimStrings{"something-else"}
+
+			valid, err := authorization.ValidateToken(signToken(claims))
+			Expect(err).To(HaveOccurred())
+			Expect(valid).To(BeFalse())
+		})
+
+		It("should reject a token with a wrong issuer", func() {
+			claims := validClaims(time.Now())
+			claims.Issuer = "something-else"
+
+			valid, err := authorization.ValidateToken(signToken(claims))
+			Expect(err).To(HaveOccurred())
+			Expect(valid).To(BeFalse())
+		})
+
+		It("should rotate a token that is about to expire", func() {
+			token := signToken(validClaims(time.Now().Add(-23 * time.Hour)))
+			Expect(authorization.ValidateToken(token)).To(BeTrue())
+			Expect(authorization.ShouldRotateToken(token)).To(BeTrue())
+		})
+	})
+
+	Context("key rotation", func() {
+		BeforeEach(func() {
+			os.Remove(tokenPath)
+			os.Setenv(authorization.TokenPathEnvVar, "random-path")
+		})
+
+		It("should accept tokens that were signed by the previous key, during the grace period", func() {
+			oldToken, err := authorization.CreateToken()
+			Expect(err).ToNot(HaveOccurred())
+
+			authorization.ReloadSecretKey()
+
+			newToken, err := authorization.CreateToken()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newToken).ToNot(Equal(oldToken))
+
+			Expect(authorization.ValidateToken(newToken)).To(BeTrue())
+			Expect(authorization.ValidateToken(oldToken)).To(BeTrue())
+
+			By("should reject the old token, if the key is rotated again")
+			authorization.ReloadSecretKey()
+			valid, err := authorization.ValidateToken(oldToken)
+			Expect(err).To(HaveOccurred())
+			Expect(valid).To(BeFalse())
+			Expect(authorization.ValidateToken(newToken)).To(BeTrue())
+		})
+
+		It("should reject tokens that were signed by the previous key, after a refresh", func() {
+			oldToken, err := authorization.CreateToken()
+			Expect(err).ToNot(HaveOccurred())
+
+			authorization.ReloadSecretKey()
+			authorization.RefreshSecretKey()
+
+			valid, err := authorization.ValidateToken(oldToken)
+			Expect(err).To(HaveOccurred())
+			Expect(valid).To(BeFalse())
+		})
+	})
+
+	Context("key reload from the ServiceAccount token file", func() {
+		It("should sign with the refreshed ServiceAccount token, and accept the previous key during the grace period", func() {
+			oldToken, err := authorization.CreateToken()
+			Expect(err).ToNot(HaveOccurred())
+
+			By("the kubelet refreshes the ServiceAccount token file")
+			Expect(os.WriteFile(tokenPath, []byte("refreshed-secret-key"), 0600)).To(Succeed())
+			authorization.ReloadSecretKey()
+
+			newToken, err := authorization.CreateToken()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authorization.ValidateToken(newToken)).To(BeTrue())
+			Expect(authorization.ValidateToken(oldToken)).To(BeTrue())
+
+			By("should reject the old token, once its key is no longer the previous key")
+			authorization.ReloadSecretKey()
+			valid, err := authorization.ValidateToken(oldToken)
+			Expect(err).To(HaveOccurred())
+			Expect(valid).To(BeFalse())
+		})
+	})
+
 	Context("with an invalid token", func() {
 		It("should fail validation for malformed token", func() {
 			valid, err := authorization.ValidateToken("invalid-token")
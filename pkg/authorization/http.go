@@ -1,6 +1,7 @@
 package authorization
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -9,11 +10,48 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 )
 
+const (
+	// MetricsAuthModeBearerToken protects the metrics endpoint with the bearer token that HCO generates
+	MetricsAuthModeBearerToken = "bearer-token"
+	// MetricsAuthModeKubernetes protects the metrics endpoint with TokenReview and SubjectAccessReview, so any client
+	// with a ServiceAccount that is allowed to get the metrics non-resource URL, can scrape the metrics.
+	MetricsAuthModeKubernetes = "kubernetes"
+)
+
+// FilterProvider is the type of the metrics server filter provider
+type FilterProvider func(c *rest.Config, httpClient *http.Client) (server.Filter, error)
+
+var metricsAuthMode = MetricsAuthModeBearerToken
+
+// SetMetricsAuthMode sets the authorization mode of the metrics endpoint
+func SetMetricsAuthMode(mode string) error {
+	switch mode {
+	case MetricsAuthModeBearerToken, MetricsAuthModeKubernetes:
+		metricsAuthMode = mode
+		return nil
+	default:
+		return fmt.Errorf("unknown metrics authorization mode %q; supported modes are %q and %q", mode, MetricsAuthModeBearerToken, MetricsAuthModeKubernetes)
+	}
+}
+
+// GetMetricsAuthMode returns the authorization mode of the metrics endpoint
+func GetMetricsAuthMode() string {
+	return metricsAuthMode
+}
+
+// GetMetricsFilterProvider returns the metrics server filter provider for the authorization mode
+func GetMetricsFilterProvider() FilterProvider {
+	if metricsAuthMode == MetricsAuthModeKubernetes {
+		return HttpWithKubernetesAuth
+	}
+
+	return HttpWithBearerToken
+}
+
 func HttpWithBearerToken(_ *rest.Config, _ *http.Client) (server.Filter, error) {
 	return func(log logr.Logger, handler http.Handler) (http.Handler, error) {
 		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-			authValue := req.Header.Get("Authorization")
-			token := strings.TrimPrefix(authValue, "Bearer ")
+			token := getBearerToken(req)
 
 			if token == "" {
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -30,3 +68,8 @@ func HttpWithBearerToken(_ *rest.Config, _ *http.Client) (server.Filter, error)
 		}), nil
 	}, nil
 }
+
+func getBearerToken(req *http.Request) string {
+	authValue := req.Header.Get("Authorization")
+	return strings.TrimPrefix(authValue, "Bearer ")
+}
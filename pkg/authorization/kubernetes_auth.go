@@ -0,0 +1,155 @@
+package authorization
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+const (
+	authCacheSize = 256
+	// the allowed decisions are cached for a short time, to avoid calling the API server on each scrape
+	allowedCacheTTL = time.Minute
+	deniedCacheTTL  = 10 * time.Second
+)
+
+// TokenReviewer creates TokenReviews
+type TokenReviewer interface {
+	Create(ctx context.Context, tokenReview *authenticationv1.TokenReview, opts metav1.CreateOptions) (*authenticationv1.TokenReview, error)
+}
+
+// SubjectAccessReviewer creates SubjectAccessReviews
+type SubjectAccessReviewer interface {
+	Create(ctx context.Context, sar *authorizationv1.SubjectAccessReview, opts metav1.CreateOptions) (*authorizationv1.SubjectAccessReview, error)
+}
+
+// HttpWithKubernetesAuth authenticates the request's bearer token with a TokenReview, and then authorizes the user
+// with a SubjectAccessReview, for the request's non-resource URL (e.g. "/metrics") and verb (e.g. "get").
+func HttpWithKubernetesAuth(cfg *rest.Config, httpClient *http.Client) (server.Filter, error) {
+	authnClient, err := authenticationv1client.NewForConfigAndClient(cfg, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	authzClient, err := authorizationv1client.NewForConfigAndClient(cfg, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKubernetesAuthFilter(authnClient.TokenReviews(), authzClient.SubjectAccessReviews()), nil
+}
+
+// NewKubernetesAuthFilter returns a metrics server filter that uses the TokenReviewer and the SubjectAccessReviewer
+// to authenticate and authorize the requests.
+func NewKubernetesAuthFilter(tokenReviewer TokenReviewer, sarReviewer SubjectAccessReviewer) server.Filter {
+	a := &kubernetesAuthorizer{
+		tokenReviewer: tokenReviewer,
+		sarReviewer:   sarReviewer,
+		decisions:     cache.NewLRUExpireCache(authCacheSize),
+	}
+
+	return func(log logr.Logger, handler http.Handler) (http.Handler, error) {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			token := getBearerToken(req)
+			if token == "" {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			status := a.authorize(req.Context(), log, token, req.URL.Path, strings.ToLower(req.Method))
+			if status != http.StatusOK {
+				http.Error(w, http.StatusText(status), status)
+				return
+			}
+
+			handler.ServeHTTP(w, req)
+		}), nil
+	}
+}
+
+type kubernetesAuthorizer struct {
+	tokenReviewer TokenReviewer
+	sarReviewer   SubjectAccessReviewer
+	decisions     *cache.LRUExpireCache
+}
+
+// authorize returns the HTTP status of the authorization decision
+func (a *kubernetesAuthorizer) authorize(ctx context.Context, log logr.Logger, token, path, verb string) int {
+	// never keep the token itself in memory
+	tokenHash := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(tokenHash[:]) + " " + verb + " " + path
+
+	if status, found := a.decisions.Get(key); found {
+		return status.(int)
+	}
+
+	status, err := a.review(ctx, token, path, verb)
+	if err != nil {
+		log.Error(err, "failed to authorize the metrics request")
+		return http.StatusInternalServerError
+	}
+
+	ttl := deniedCacheTTL
+	if status == http.StatusOK {
+		ttl = allowedCacheTTL
+	}
+	a.decisions.Add(key, status, ttl)
+
+	return status
+}
+
+func (a *kubernetesAuthorizer) review(ctx context.Context, token, path, verb string) (int, error) {
+	tokenReview, err := a.tokenReviewer.Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: token,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	if !tokenReview.Status.Authenticated {
+		return http.StatusUnauthorized, nil
+	}
+
+	user := tokenReview.Status.User
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	sar, err := a.sarReviewer.Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+				Path: path,
+				Verb: verb,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	if !sar.Status.Allowed {
+		return http.StatusForbidden, nil
+	}
+
+	return http.StatusOK, nil
+}
@@ -0,0 +1,155 @@
+package authorization_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
+)
+
+var _ = Describe("Kubernetes authorization", func() {
+	const (
+		validToken   = "valid-token"
+		allowedToken = "allowed-token"
+	)
+
+	var (
+		tokenReviewer *fakeTokenReviewer
+		sarReviewer   *fakeSARReviewer
+		handler       http.Handler
+	)
+
+	BeforeEach(func() {
+		tokenReviewer = &fakeTokenReviewer{validTokens: map[string]string{validToken: "user", allowedToken: "prometheus"}}
+		sarReviewer = &fakeSARReviewer{allowedUsers: map[string]bool{"prometheus": true}}
+
+		filter := authorization.NewKubernetesAuthFilter(tokenReviewer, sarReviewer)
+
+		var err error
+		handler, err = filter(logr.Discard(), http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	serve := func(token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	It("should reject a request with no token", func() {
+		Expect(serve("")).To(Equal(http.StatusUnauthorized))
+		Expect(tokenReviewer.calls).To(BeZero())
+	})
+
+	It("should reject a token that is not authenticated", func() {
+		Expect(serve("wrong-token")).To(Equal(http.StatusUnauthorized))
+		Expect(sarReviewer.calls).To(BeZero())
+	})
+
+	It("should reject an authenticated user that is not allowed to get the metrics", func() {
+		Expect(serve(validToken)).To(Equal(http.StatusForbidden))
+		Expect(sarReviewer.calls).To(Equal(1))
+	})
+
+	It("should allow an authenticated user that is allowed to get the metrics", func() {
+		Expect(serve(allowedToken)).To(Equal(http.StatusOK))
+
+		Expect(sarReviewer.lastSpec.User).To(Equal("prometheus"))
+		Expect(sarReviewer.lastSpec.NonResourceAttributes).To(Equal(&authorizationv1.NonResourceAttributes{
+			Path: "/metrics",
+			Verb: "get",
+		}))
+	})
+
+	It("should cache the decisions", func() {
+		Expect(serve(allowedToken)).To(Equal(http.StatusOK))
+		Expect(serve(allowedToken)).To(Equal(http.StatusOK))
+		Expect(serve(validToken)).To(Equal(http.StatusForbidden))
+		Expect(serve(validToken)).To(Equal(http.StatusForbidden))
+
+		Expect(tokenReviewer.calls).To(Equal(2))
+		Expect(sarReviewer.calls).To(Equal(2))
+	})
+
+	It("should not cache API errors", func() {
+		tokenReviewer.err = errors.New("fake error")
+		Expect(serve(allowedToken)).To(Equal(http.StatusInternalServerError))
+
+		tokenReviewer.err = nil
+		Expect(serve(allowedToken)).To(Equal(http.StatusOK))
+	})
+
+	Context("authorization mode", func() {
+		AfterEach(func() {
+			Expect(authorization.SetMetricsAuthMode(authorization.MetricsAuthModeBearerToken)).To(Succeed())
+		})
+
+		It("should use the bearer token mode by default", func() {
+			Expect(authorization.GetMetricsAuthMode()).To(Equal(authorization.MetricsAuthModeBearerToken))
+		})
+
+		It("should set the kubernetes mode", func() {
+			Expect(authorization.SetMetricsAuthMode(authorization.MetricsAuthModeKubernetes)).To(Succeed())
+			Expect(authorization.GetMetricsAuthMode()).To(Equal(authorization.MetricsAuthModeKubernetes))
+		})
+
+		It("should reject an unknown mode", func() {
+			Expect(authorization.SetMetricsAuthMode("unknown")).To(MatchError(ContainSubstring(`unknown metrics authorization mode "unknown"`)))
+			Expect(authorization.GetMetricsAuthMode()).To(Equal(authorization.MetricsAuthModeBearerToken))
+		})
+	})
+})
+
+type fakeTokenReviewer struct {
+	validTokens map[string]string
+	calls       int
+	err         error
+}
+
+func (f *fakeTokenReviewer) Create(_ context.Context, tokenReview *authenticationv1.TokenReview, _ metav1.CreateOptions) (*authenticationv1.TokenReview, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	result := tokenReview.DeepCopy()
+	if user, ok := f.validTokens[tokenReview.Spec.Token]; ok {
+		result.Status.Authenticated = true
+		result.Status.User = authenticationv1.UserInfo{Username: user}
+	}
+
+	return result, nil
+}
+
+type fakeSARReviewer struct {
+	allowedUsers map[string]bool
+	calls        int
+	lastSpec     authorizationv1.SubjectAccessReviewSpec
+}
+
+func (f *fakeSARReviewer) Create(_ context.Context, sar *authorizationv1.SubjectAccessReview, _ metav1.CreateOptions) (*authorizationv1.SubjectAccessReview, error) {
+	f.calls++
+	f.lastSpec = sar.Spec
+
+	result := sar.DeepCopy()
+	result.Status.Allowed = f.allowedUsers[sar.Spec.User]
+
+	return result, nil
+}
@@ -215,7 +215,7 @@ func buildEnvVars(params *DeploymentOperatorParams) []corev1.EnvVar {
 			Value: "",
 		},
 		{
-			Name:  "OPERATOR_IMAGE",
+			Name:  util.OperatorImageEnvV,
 			Value: params.Image,
 		},
 		{
@@ -235,7 +235,7 @@ func buildEnvVars(params *DeploymentOperatorParams) []corev1.EnvVar {
 			},
 		},
 		{
-			Name:  "VIRTIOWIN_CONTAINER",
+			Name:  util.VirtioWinImageEnvV,
 			Value: params.VirtIOWinContainer,
 		},
 		{
@@ -463,7 +463,7 @@ func GetDeploymentSpecWebhook(params *DeploymentOperatorParams) appsv1.Deploymen
 								Value: util.ContainerWebhookApp,
 							},
 							{
-								Name:  "OPERATOR_IMAGE",
+								Name:  util.OperatorImageEnvV,
 								Value: params.WebhookImage,
 							},
 							{
@@ -542,7 +542,17 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Verbs:     stringListToSlice("get", "list", "create", "update", "watch"),
 		},
 		roleWithAllPermissions(kvapi.GroupName, stringListToSlice("kubevirts", "kubevirts/finalizers")),
+		{
+			APIGroups: stringListToSlice(kvapi.GroupName),
+			Resources: stringListToSlice("virtualmachineinstances"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
 		roleWithAllPermissions(cdiapi.GroupName, stringListToSlice("cdis", "cdis/finalizers")),
+		{
+			APIGroups: stringListToSlice(cdiapi.GroupName),
+			Resources: stringListToSlice("dataimportcrons", "datasources"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
 		roleWithAllPermissions(sspapi.GroupVersion.Group, stringListToSlice("ssps", "ssps/finalizers")),
 		roleWithAllPermissions(cnaoapi.GroupVersion.Group, stringListToSlice("networkaddonsconfigs", "networkaddonsconfigs/finalizers")),
 		roleWithAllPermissions(aaqapi.GroupName, stringListToSlice("aaqs", "aaqs/finalizers")),
@@ -573,7 +583,7 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		{
 			APIGroups: stringListToSlice("apps"),
 			Resources: stringListToSlice("deployments", "replicasets", "daemonsets"),
-			Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "delete"),
+			Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "patch", "delete"),
 		},
 		roleWithAllPermissions("rbac.authorization.k8s.io",
 			stringListToSlice("roles", "clusterroles", "rolebindings", "clusterrolebindings")),
@@ -601,8 +611,15 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		{
 			APIGroups: stringListToSlice("admissionregistration.k8s.io"),
 			Resources: stringListToSlice("validatingwebhookconfigurations"),
-			Verbs:     stringListToSlice("list", "watch", "update", "patch"),
+			Verbs:     stringListToSlice("get", "list", "watch", "update", "patch"),
 		},
+		{
+			APIGroups: stringListToSlice("admissionregistration.k8s.io"),
+			Resources: stringListToSlice("mutatingwebhookconfigurations"),
+			Verbs:     stringListToSlice("get", "patch"),
+		},
+		roleWithAllPermissions("cert-manager.io", stringListToSlice("certificates")),
+		roleWithAllPermissions("policy.networking.k8s.io", stringListToSlice("baselineadminnetworkpolicies")),
 		roleWithAllPermissions("console.openshift.io", stringListToSlice("consoleclidownloads", "consolequickstarts")),
 		{
 			APIGroups: stringListToSlice(configOpenshiftIO),
@@ -621,7 +638,7 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		},
 		{
 			APIGroups: stringListToSlice(configOpenshiftIO),
-			Resources: stringListToSlice("apiservers"),
+			Resources: stringListToSlice("apiservers", "proxies"),
 			Verbs:     stringListToSlice("get", "list", "watch"),
 		},
 		{
@@ -680,7 +697,7 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		},
 		{
 			APIGroups: stringListToSlice(admissionregistrationv1.GroupName),
-			Resources: stringListToSlice("validatingadmissionpolicies", "validatingadmissionpolicybindings"),
+			Resources: stringListToSlice("validatingadmissionpolicies", "validatingadmissionpolicybindings", "mutatingadmissionpolicies", "mutatingadmissionpolicybindings"),
 			Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "delete"),
 		},
 		{
@@ -688,6 +705,16 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Resources: stringListToSlice("persesdashboards", "persesdatasources"),
 			Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "delete"),
 		},
+		{
+			APIGroups: stringListToSlice("authentication.k8s.io"),
+			Resources: stringListToSlice("tokenreviews"),
+			Verbs:     stringListToSlice("create"),
+		},
+		{
+			APIGroups: stringListToSlice("authorization.k8s.io"),
+			Resources: stringListToSlice("subjectaccessreviews"),
+			Verbs:     stringListToSlice("create"),
+		},
 	}
 }
 
@@ -0,0 +1,337 @@
+// Package featuregates is the registry of the HyperConverged feature gates. Each feature gate records its maturity,
+// its default value and the operand feature gates it enables. The webhook warnings, the KubeVirt and CDI feature gate
+// lists, the API documentation and the feature gate metric are all derived from this registry, so adding a feature
+// gate to the HyperConverged API only requires adding it here.
+package featuregates
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// Maturity is the maturity level of a feature gate
+type Maturity string
+
+const (
+	// DevPreview features are not supported, and may be changed or removed in any version
+	DevPreview Maturity = "DevPreview"
+	// TechPreview features are not fully supported yet
+	TechPreview Maturity = "TechPreview"
+	// GA features are fully supported
+	GA Maturity = "GA"
+	// Deprecated feature gates are ignored; the feature was moved to another field of the HyperConverged CR
+	Deprecated Maturity = "Deprecated"
+	// Removed feature gates are ignored; the feature does not exist anymore, or it is always enabled
+	Removed Maturity = "Removed"
+)
+
+// KubeVirt feature gates that are enabled by the HyperConverged feature gates
+const (
+	KvDownwardMetrics               = "DownwardMetrics"
+	KvDisableMDevConfig             = "DisableMDEVConfiguration"
+	KvPersistentReservation         = "PersistentReservation"
+	KvAlignCPUs                     = "AlignCPUs"
+	KvDecentralizedLiveMigration    = "DecentralizedLiveMigration"
+	KvMultiArchitecture             = "MultiArchitecture"
+	KvVideoConfig                   = "VideoConfig"
+	KvObjectGraph                   = "ObjectGraph"
+	KvHotplugVolumesGate            = "HotplugVolumes"
+	KvDeclarativeHotplugVolumesGate = "DeclarativeHotplugVolumes"
+)
+
+// KubeVirt feature gates that HCO always enables. They can't be modified by the end user.
+const (
+	// Enables the CPUManager feature gate to label the nodes which have the Kubernetes CPUManager running. VMIs that
+	// require dedicated CPU resources will automatically be scheduled on the labeled nodes
+	KvCPUManagerGate = "CPUManager"
+	// Enables the alpha offline snapshot functionality
+	KvSnapshotGate = "Snapshot"
+	// Allow assigning host devices to virtual machines
+	KvHostDevicesGate = "HostDevices"
+	// Expand disks to the largest size
+	KvExpandDisksGate = "ExpandDisks"
+	// Export VMs to outside of the cluster
+	KvVMExportGate = "VMExport"
+	// Enable the installation of the KubeVirt seccomp profile
+	KvKubevirtSeccompProfile = "KubevirtSeccompProfile"
+	// Support migration for VMs with host-model CPU mode
+	KvWithHostModelCPU = "WithHostModelCPU"
+	// Enable HyperV strict host checking for HyperV enlightenments
+	KvHypervStrictCheck = "HypervStrictCheck"
+)
+
+const (
+	deprecationWarning = "spec.featureGates.%s is deprecated and ignored. It will be removed in a future version;"
+	movedWarning       = "spec.featureGates.%[1]s is deprecated and ignored. It will removed in a future version; use %[2]s instead"
+)
+
+// FeatureGate describes a feature gate of the HyperConverged CR
+type FeatureGate struct {
+	// Name is the name of the feature gate in spec.featureGates
+	Name string
+	// Maturity is the maturity level of the feature
+	Maturity Maturity
+	// Default is the value of the feature gate when it is not set
+	Default bool
+	// KubeVirtGates are the KubeVirt feature gates that are enabled when the feature gate is enabled
+	KubeVirtGates []string
+	// KubeVirtGatesWhenDisabled are the KubeVirt feature gates that are enabled when the feature gate is disabled
+	KubeVirtGatesWhenDisabled []string
+	// CDIGates are the CDI feature gates that are enabled when the feature gate is enabled
+	CDIGates []string
+	// ReplacedBy is the field that replaces a deprecated feature gate
+	ReplacedBy string
+
+	value func(*v1beta1.HyperConvergedFeatureGates) *bool
+}
+
+// IsIgnored returns true if HCO ignores the value of the feature gate
+func (fg FeatureGate) IsIgnored() bool {
+	return fg.Maturity == Deprecated || fg.Maturity == Removed
+}
+
+// IsSet returns true if the feature gate is set in the HyperConverged CR
+func (fg FeatureGate) IsSet(fgs *v1beta1.HyperConvergedFeatureGates) bool {
+	return fg.value(fgs) != nil
+}
+
+// IsEnabled returns the value of the feature gate, or its default value if it is not set. An ignored feature gate is
+// never enabled.
+func (fg FeatureGate) IsEnabled(fgs *v1beta1.HyperConvergedFeatureGates) bool {
+	if fg.IsIgnored() {
+		return false
+	}
+	return ptr.Deref(fg.value(fgs), fg.Default)
+}
+
+//nolint:staticcheck // the deprecated feature gates are registered, to warn about them
+var registry = []FeatureGate{
+	{
+		Name:          "downwardMetrics",
+		Maturity:      DevPreview,
+		KubeVirtGates: []string{KvDownwardMetrics},
+		value:         func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.DownwardMetrics },
+	},
+	{
+		Name:          "disableMDevConfiguration",
+		Maturity:      DevPreview,
+		KubeVirtGates: []string{KvDisableMDevConfig},
+		value:         func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.DisableMDevConfiguration },
+	},
+	{
+		Name:          "persistentReservation",
+		Maturity:      DevPreview,
+		KubeVirtGates: []string{KvPersistentReservation},
+		value:         func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.PersistentReservation },
+	},
+	{
+		Name:          "alignCPUs",
+		Maturity:      DevPreview,
+		KubeVirtGates: []string{KvAlignCPUs},
+		value:         func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.AlignCPUs },
+	},
+	{
+		Name:          "enableMultiArchBootImageImport",
+		Maturity:      DevPreview,
+		KubeVirtGates: []string{KvMultiArchitecture},
+		value:         func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.EnableMultiArchBootImageImport },
+	},
+	{
+		Name:          "decentralizedLiveMigration",
+		Maturity:      DevPreview,
+		KubeVirtGates: []string{KvDecentralizedLiveMigration},
+		value:         func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.DecentralizedLiveMigration },
+	},
+	{
+		Name:                      "declarativeHotplugVolumes",
+		Maturity:                  DevPreview,
+		KubeVirtGates:             []string{KvDeclarativeHotplugVolumesGate},
+		KubeVirtGatesWhenDisabled: []string{KvHotplugVolumesGate},
+		value:                     func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.DeclarativeHotplugVolumes },
+	},
+	{
+		Name:          "videoConfig",
+		Maturity:      TechPreview,
+		Default:       true,
+		KubeVirtGates: []string{KvVideoConfig},
+		value:         func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.VideoConfig },
+	},
+	{
+		Name:          "objectGraph",
+		Maturity:      DevPreview,
+		KubeVirtGates: []string{KvObjectGraph},
+		value:         func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.ObjectGraph },
+	},
+	{
+		Name:       "enableCommonBootImageImport",
+		Maturity:   Deprecated,
+		ReplacedBy: "spec.enableCommonBootImageImport",
+		value:      func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.EnableCommonBootImageImport },
+	},
+	{
+		Name:       "deployVmConsoleProxy",
+		Maturity:   Deprecated,
+		ReplacedBy: "spec.deployVmConsoleProxy",
+		value:      func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.DeployVMConsoleProxy },
+	},
+	{
+		Name:       "enableApplicationAwareQuota",
+		Maturity:   Deprecated,
+		ReplacedBy: "spec.enableApplicationAwareQuota",
+		value:      func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.EnableApplicationAwareQuota },
+	},
+	{
+		Name:       "deployKubeSecondaryDNS",
+		Maturity:   Deprecated,
+		ReplacedBy: "spec.kubeSecondaryDNS.deploy",
+		value:      func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.DeployKubeSecondaryDNS },
+	},
+	{
+		Name:     "withHostPassthroughCPU",
+		Maturity: Removed,
+		value:    func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.WithHostPassthroughCPU },
+	},
+	{
+		Name:     "deployTektonTaskResources",
+		Maturity: Removed,
+		value:    func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.DeployTektonTaskResources },
+	},
+	{
+		Name:     "deployKubevirtIpamController",
+		Maturity: Removed,
+		value:    func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.DeployKubevirtIpamController },
+	},
+	{
+		Name:     "nonRoot",
+		Maturity: Removed,
+		value:    func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.NonRoot },
+	},
+	{
+		Name:     "enableManagedTenantQuota",
+		Maturity: Removed,
+		value:    func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.EnableManagedTenantQuota },
+	},
+	{
+		Name:     "autoResourceLimits",
+		Maturity: Removed,
+		value:    func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.AutoResourceLimits },
+	},
+	{
+		Name:     "primaryUserDefinedNetworkBinding",
+		Maturity: Removed,
+		value:    func(fgs *v1beta1.HyperConvergedFeatureGates) *bool { return fgs.PrimaryUserDefinedNetworkBinding },
+	},
+}
+
+// mandatoryKubeVirtGates are the KubeVirt feature gates that HCO always enables
+var mandatoryKubeVirtGates = []string{
+	KvCPUManagerGate,
+	KvSnapshotGate,
+	KvExpandDisksGate,
+	KvHostDevicesGate,
+	KvVMExportGate,
+	KvKubevirtSeccompProfile,
+}
+
+// hardwareKubeVirtGates are the KubeVirt feature gates that HCO enables, unless KubeVirt uses KVM emulation
+var hardwareKubeVirtGates = []string{
+	KvWithHostModelCPU,
+	KvHypervStrictCheck,
+}
+
+// All returns all the registered feature gates
+func All() []FeatureGate {
+	return slices.Clone(registry)
+}
+
+// Get returns the feature gate with the given name
+func Get(name string) (FeatureGate, bool) {
+	idx := slices.IndexFunc(registry, func(fg FeatureGate) bool {
+		return fg.Name == name
+	})
+
+	if idx < 0 {
+		return FeatureGate{}, false
+	}
+
+	return registry[idx], true
+}
+
+// KubeVirtFeatureGates returns the KubeVirt feature gates to enable, according to the HyperConverged feature gates
+func KubeVirtFeatureGates(fgs *v1beta1.HyperConvergedFeatureGates) []string {
+	var kvFGs []string
+	for _, fg := range registry {
+		if fg.IsEnabled(fgs) {
+			kvFGs = append(kvFGs, fg.KubeVirtGates...)
+		} else if !fg.IsIgnored() {
+			kvFGs = append(kvFGs, fg.KubeVirtGatesWhenDisabled...)
+		}
+	}
+	return kvFGs
+}
+
+// MandatoryKubeVirtFeatureGates returns the KubeVirt feature gates that HCO always enables, regardless of the
+// HyperConverged feature gates
+func MandatoryKubeVirtFeatureGates(isKVMEmulation bool) []string {
+	if isKVMEmulation {
+		return slices.Clone(mandatoryKubeVirtGates)
+	}
+	return slices.Concat(mandatoryKubeVirtGates, hardwareKubeVirtGates)
+}
+
+// CDIFeatureGates returns the CDI feature gates to enable, according to the HyperConverged feature gates
+func CDIFeatureGates(fgs *v1beta1.HyperConvergedFeatureGates) []string {
+	var cdiFGs []string
+	for _, fg := range registry {
+		if fg.IsEnabled(fgs) {
+			cdiFGs = append(cdiFGs, fg.CDIGates...)
+		}
+	}
+	return cdiFGs
+}
+
+// WarningsOnCreate returns the webhook warnings for the ignored feature gates that are set in a new HyperConverged CR
+func WarningsOnCreate(fgs *v1beta1.HyperConvergedFeatureGates) []string {
+	var warnings []string
+	for _, fg := range registry {
+		if fg.IsIgnored() && fg.IsSet(fgs) {
+			warnings = append(warnings, fg.warning())
+		}
+	}
+	return warnings
+}
+
+// WarningsOnUpdate returns the webhook warnings for the ignored feature gates that are set in an updated
+// HyperConverged CR. Deprecated feature gates only trigger a warning if they were modified, as the upgrade process
+// moves their values to the new fields.
+func WarningsOnUpdate(fgs, prevFGs *v1beta1.HyperConvergedFeatureGates) []string {
+	var warnings []string
+	for _, fg := range registry {
+		switch fg.Maturity {
+		case Removed:
+			if fg.IsSet(fgs) {
+				warnings = append(warnings, fg.warning())
+			}
+		case Deprecated:
+			if changed(fg.value(fgs), fg.value(prevFGs)) {
+				warnings = append(warnings, fg.warning())
+			}
+		}
+	}
+	return warnings
+}
+
+func (fg FeatureGate) warning() string {
+	if fg.ReplacedBy != "" {
+		return fmt.Sprintf(movedWarning, fg.Name, fg.ReplacedBy)
+	}
+	return fmt.Sprintf(deprecationWarning, fg.Name)
+}
+
+func changed(newFG, prevFG *bool) bool {
+	return newFG != nil && (prevFG == nil || *newFG != *prevFG)
+}
@@ -0,0 +1,124 @@
+package featuregates
+
+import (
+	"reflect"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+var _ = Describe("Feature gates registry", func() {
+	It("should register all the feature gates of the HyperConverged API", func() {
+		fgsType := reflect.TypeOf(v1beta1.HyperConvergedFeatureGates{})
+		Expect(registry).To(HaveLen(fgsType.NumField()))
+
+		for i := range fgsType.NumField() {
+			field := fgsType.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			fg, found := Get(name)
+			Expect(found).To(BeTrue(), "feature gate %s is not registered", name)
+
+			// make sure the feature gate reads its own field
+			fgs := &v1beta1.HyperConvergedFeatureGates{}
+			reflect.ValueOf(fgs).Elem().Field(i).Set(reflect.ValueOf(ptr.To(true)))
+			Expect(fg.IsSet(fgs)).To(BeTrue(), "feature gate %s reads the wrong field", name)
+		}
+	})
+
+	It("should only set the replacing field for deprecated feature gates", func() {
+		for _, fg := range All() {
+			Expect(fg.ReplacedBy != "").To(Equal(fg.Maturity == Deprecated), "feature gate %s", fg.Name)
+		}
+	})
+
+	It("should never enable ignored feature gates", func() {
+		fgs := &v1beta1.HyperConvergedFeatureGates{
+			NonRoot:                     ptr.To(true), //nolint:staticcheck
+			EnableCommonBootImageImport: ptr.To(true), //nolint:staticcheck
+		}
+
+		for _, name := range []string{"nonRoot", "enableCommonBootImageImport"} {
+			fg, found := Get(name)
+			Expect(found).To(BeTrue())
+			Expect(fg.IsSet(fgs)).To(BeTrue())
+			Expect(fg.IsEnabled(fgs)).To(BeFalse())
+		}
+	})
+
+	Context("KubeVirtFeatureGates", func() {
+		It("should return the default KubeVirt feature gates", func() {
+			Expect(KubeVirtFeatureGates(&v1beta1.HyperConvergedFeatureGates{})).To(ConsistOf(KvHotplugVolumesGate, KvVideoConfig))
+		})
+
+		It("should return the KubeVirt feature gates of the enabled feature gates", func() {
+			fgs := &v1beta1.HyperConvergedFeatureGates{
+				DownwardMetrics:           ptr.To(true),
+				DeclarativeHotplugVolumes: ptr.To(true),
+				VideoConfig:               ptr.To(false),
+				DeployKubeSecondaryDNS:    ptr.To(true),
+			}
+
+			Expect(KubeVirtFeatureGates(fgs)).To(ConsistOf(KvDownwardMetrics, KvDeclarativeHotplugVolumesGate))
+		})
+	})
+
+	Context("MandatoryKubeVirtFeatureGates", func() {
+		It("should return the hardware dependant KubeVirt feature gates, if not using KVM emulation", func() {
+			Expect(MandatoryKubeVirtFeatureGates(false)).To(ConsistOf(
+				KvCPUManagerGate, KvSnapshotGate, KvExpandDisksGate, KvHostDevicesGate, KvVMExportGate,
+				KvKubevirtSeccompProfile, KvWithHostModelCPU, KvHypervStrictCheck,
+			))
+		})
+
+		It("should not return the hardware dependant KubeVirt feature gates, if using KVM emulation", func() {
+			Expect(MandatoryKubeVirtFeatureGates(true)).To(ConsistOf(
+				KvCPUManagerGate, KvSnapshotGate, KvExpandDisksGate, KvHostDevicesGate, KvVMExportGate,
+				KvKubevirtSeccompProfile,
+			))
+		})
+
+		It("should not allow modifying the registry", func() {
+			fgs := MandatoryKubeVirtFeatureGates(true)
+			fgs[0] = "modified"
+			Expect(MandatoryKubeVirtFeatureGates(true)).ToNot(ContainElement("modified"))
+		})
+	})
+
+	Context("warnings", func() {
+		It("should warn about all the ignored feature gates on create", func() {
+			fgs := &v1beta1.HyperConvergedFeatureGates{
+				DownwardMetrics:             ptr.To(true),
+				AutoResourceLimits:          ptr.To(false), //nolint:staticcheck
+				DeployVMConsoleProxy:        ptr.To(true),  //nolint:staticcheck
+				EnableApplicationAwareQuota: ptr.To(false), //nolint:staticcheck
+			}
+
+			Expect(WarningsOnCreate(fgs)).To(ConsistOf(
+				"spec.featureGates.autoResourceLimits is deprecated and ignored. It will be removed in a future version;",
+				"spec.featureGates.deployVmConsoleProxy is deprecated and ignored. It will removed in a future version; use spec.deployVmConsoleProxy instead",
+				"spec.featureGates.enableApplicationAwareQuota is deprecated and ignored. It will removed in a future version; use spec.enableApplicationAwareQuota instead",
+			))
+		})
+
+		It("should only warn about modified deprecated feature gates on update", func() {
+			prevFGs := &v1beta1.HyperConvergedFeatureGates{
+				DeployVMConsoleProxy:        ptr.To(true),  //nolint:staticcheck
+				EnableApplicationAwareQuota: ptr.To(false), //nolint:staticcheck
+			}
+			fgs := &v1beta1.HyperConvergedFeatureGates{
+				DeployVMConsoleProxy:        ptr.To(true), //nolint:staticcheck
+				EnableApplicationAwareQuota: ptr.To(true), //nolint:staticcheck
+				NonRoot:                     ptr.To(true), //nolint:staticcheck
+			}
+
+			Expect(WarningsOnUpdate(fgs, prevFGs)).To(ConsistOf(
+				ContainSubstring("enableApplicationAwareQuota"),
+				ContainSubstring("nonRoot"),
+			))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package collectors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const labelConfigMap = "configmap"
+
+var (
+	// the CA bundles that the operands publish in the HCO namespace
+	operandCABundles = []string{
+		"kubevirt-ca",
+		"cdi-apiserver-signer-bundle",
+		"cdi-uploadproxy-signer-bundle",
+	}
+
+	caBundleExpiration = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_ca_bundle_expiration_timestamp_seconds",
+			Help: "The expiration time of the latest certificate in an operand CA bundle, by the CA bundle ConfigMap name, in seconds since the epoch",
+		},
+		[]string{labelConfigMap},
+	)
+)
+
+// getCABundlesExpirationCollector reads the CA bundle ConfigMaps on each scrape. The ConfigMaps are not labeled
+// by HCO, so they are not in the cache; reader should be an uncached client.
+func getCABundlesExpirationCollector(reader client.Reader, operatorNamespace string) operatormetrics.Collector {
+	return operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			caBundleExpiration,
+		},
+		CollectCallback: getCABundlesExpirationCallback(reader, operatorNamespace),
+	}
+}
+
+func getCABundlesExpirationCallback(reader client.Reader, operatorNamespace string) func() []operatormetrics.CollectorResult {
+	return func() []operatormetrics.CollectorResult {
+		results := make([]operatormetrics.CollectorResult, 0, len(operandCABundles))
+
+		for _, name := range operandCABundles {
+			cm := &corev1.ConfigMap{}
+			if err := reader.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: operatorNamespace}, cm); err != nil {
+				if !errors.IsNotFound(err) {
+					logger.Error(err, "can't read the CA bundle ConfigMap", "name", name)
+				}
+				continue
+			}
+
+			// the key of the bundle is different for each operand
+			var bundle strings.Builder
+			for _, data := range cm.Data {
+				bundle.WriteString(data)
+				bundle.WriteString("\n")
+			}
+
+			expiration, err := hcoutil.GetCABundleExpiration([]byte(bundle.String()))
+			if err != nil {
+				logger.Error(err, "can't parse the CA bundle", "name", name)
+				continue
+			}
+
+			results = append(results, operatormetrics.CollectorResult{
+				Metric: caBundleExpiration,
+				Labels: []string{name},
+				Value:  float64(expiration.Unix()),
+			})
+		}
+
+		return results
+	}
+}
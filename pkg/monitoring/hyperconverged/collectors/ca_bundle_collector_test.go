@@ -0,0 +1,79 @@
+package collectors
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("CA bundles expiration collector", func() {
+	It("should report the latest expiration time of each CA bundle", func() {
+		expiration := time.Now().Add(24 * time.Hour)
+		kvCA := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt-ca", Namespace: commontestutils.Namespace},
+			Data: map[string]string{
+				"ca-bundle": generateCACert(time.Now().Add(time.Hour)) + generateCACert(expiration),
+			},
+		}
+		cdiCA := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "cdi-apiserver-signer-bundle", Namespace: commontestutils.Namespace},
+			Data: map[string]string{
+				"ca-bundle.crt": generateCACert(expiration),
+			},
+		}
+
+		cli := commontestutils.InitClient([]client.Object{kvCA, cdiCA})
+
+		results := getCABundlesExpirationCallback(cli, commontestutils.Namespace)()
+		Expect(results).To(HaveLen(2))
+		for _, res := range results {
+			Expect(res.Labels).To(HaveLen(1))
+			Expect(res.Labels[0]).To(BeElementOf("kubevirt-ca", "cdi-apiserver-signer-bundle"))
+			Expect(res.Value).To(Equal(float64(expiration.Unix())))
+		}
+	})
+
+	It("should ignore a malformed CA bundle", func() {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt-ca", Namespace: commontestutils.Namespace},
+			Data: map[string]string{
+				"ca-bundle": "not a certificate",
+			},
+		}
+
+		cli := commontestutils.InitClient([]client.Object{cm})
+
+		Expect(getCABundlesExpirationCallback(cli, commontestutils.Namespace)()).To(BeEmpty())
+	})
+})
+
+func generateCACert(notAfter time.Time) string {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		NotBefore:             time.Now(),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &privKey.PublicKey, privKey)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}))
+}
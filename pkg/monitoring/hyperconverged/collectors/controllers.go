@@ -5,9 +5,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func SetupCollectors(cli client.Client, namespace string) error {
+func SetupCollectors(cli client.Client, apiReader client.Reader, namespace string) error {
 	err := operatormetrics.RegisterCollector(
 		getMultiArchBootImagesStatusCollector(cli, namespace),
+		getCABundlesExpirationCollector(apiReader, namespace),
+		getFeatureGatesStatusCollector(cli, namespace),
 	)
 
 	if err != nil {
@@ -0,0 +1,68 @@
+package collectors
+
+import (
+	"context"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
+)
+
+const (
+	labelFeatureGateName     = "name"
+	labelFeatureGateMaturity = "maturity"
+
+	featureGateEnabled  = float64(1)
+	featureGateDisabled = float64(0)
+)
+
+var featureGateStatus = operatormetrics.NewGaugeVec(
+	operatormetrics.MetricOpts{
+		Name: "kubevirt_hco_feature_gate_enabled",
+		Help: "Indicates if a HyperConverged feature gate is enabled (1) or not (0), by the feature gate name and maturity. Deprecated and removed feature gates are ignored, and are always reported as disabled",
+	},
+	[]string{labelFeatureGateName, labelFeatureGateMaturity},
+)
+
+func getFeatureGatesStatusCollector(cli client.Client, operatorNamespace string) operatormetrics.Collector {
+	return operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			featureGateStatus,
+		},
+		CollectCallback: getFeatureGatesStatusCallback(cli, operatorNamespace),
+	}
+}
+
+func getFeatureGatesStatusCallback(cli client.Client, operatorNamespace string) func() []operatormetrics.CollectorResult {
+	return func() []operatormetrics.CollectorResult {
+		hc := hcov1beta1.HyperConverged{}
+		key := client.ObjectKey{Name: hcov1beta1.HyperConvergedName, Namespace: operatorNamespace}
+		if err := cli.Get(context.TODO(), key, &hc); err != nil {
+			if !errors.IsNotFound(err) {
+				logger.Error(err, "can't read HyperConverged CR")
+			}
+			// Don't set the metric if the HyperConverged CR does not exist
+			return []operatormetrics.CollectorResult{}
+		}
+
+		fgs := featuregates.All()
+		results := make([]operatormetrics.CollectorResult, 0, len(fgs))
+		for _, fg := range fgs {
+			value := featureGateDisabled
+			if fg.IsEnabled(&hc.Spec.FeatureGates) {
+				value = featureGateEnabled
+			}
+
+			results = append(results, operatormetrics.CollectorResult{
+				Metric: featureGateStatus,
+				Labels: []string{fg.Name, string(fg.Maturity)},
+				Value:  value,
+			})
+		}
+
+		return results
+	}
+}
@@ -0,0 +1,59 @@
+package collectors
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
+)
+
+var _ = Describe("Feature gates collector", func() {
+	getValues := func(cli client.Client) map[string]float64 {
+		GinkgoHelper()
+
+		results := getFeatureGatesStatusCallback(cli, commontestutils.Namespace)()
+		Expect(results).To(HaveLen(len(featuregates.All())))
+
+		values := make(map[string]float64, len(results))
+		for _, res := range results {
+			Expect(res.Labels).To(HaveLen(2))
+			fg, found := featuregates.Get(res.Labels[0])
+			Expect(found).To(BeTrue())
+			Expect(res.Labels[1]).To(Equal(string(fg.Maturity)))
+			values[res.Labels[0]] = res.Value
+		}
+
+		return values
+	}
+
+	It("should report the default values of the feature gates", func() {
+		hco := commontestutils.NewHco()
+		cli := commontestutils.InitClient([]client.Object{hco})
+
+		values := getValues(cli)
+		Expect(values).To(HaveKeyWithValue("videoConfig", featureGateEnabled))
+		Expect(values).To(HaveKeyWithValue("downwardMetrics", featureGateDisabled))
+	})
+
+	It("should report the feature gates set in the HyperConverged CR", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.FeatureGates.DownwardMetrics = ptr.To(true)
+		hco.Spec.FeatureGates.VideoConfig = ptr.To(false)
+		hco.Spec.FeatureGates.NonRoot = ptr.To(true) //nolint:staticcheck
+		cli := commontestutils.InitClient([]client.Object{hco})
+
+		values := getValues(cli)
+		Expect(values).To(HaveKeyWithValue("videoConfig", featureGateDisabled))
+		Expect(values).To(HaveKeyWithValue("downwardMetrics", featureGateEnabled))
+		Expect(values).To(HaveKeyWithValue("nonRoot", featureGateDisabled))
+	})
+
+	It("should not report the feature gates if the HyperConverged CR does not exist", func() {
+		cli := commontestutils.InitClient([]client.Object{})
+
+		Expect(getFeatureGatesStatusCallback(cli, commontestutils.Namespace)()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"time"
+
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+const (
+	labelSecretName = "secret"
+)
+
+var (
+	bearerTokenMetrics = []operatormetrics.Metric{
+		metricsTokenRotations,
+		metricsTokenExpiration,
+	}
+
+	metricsTokenRotations = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_metrics_bearer_token_rotations_total",
+			Help: "Count of the rotations of the bearer token that is used to scrape the metrics endpoint, by the token Secret name",
+		},
+		[]string{labelSecretName},
+	)
+
+	metricsTokenExpiration = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_metrics_bearer_token_expiration_timestamp_seconds",
+			Help: "The expiration time of the bearer token that is used to scrape the metrics endpoint, by the token Secret name, in seconds since the epoch",
+		},
+		[]string{labelSecretName},
+	)
+)
+
+// IncMetricsTokenRotations increments the bearer token rotations counter
+func IncMetricsTokenRotations(secretName string) {
+	metricsTokenRotations.WithLabelValues(secretName).Inc()
+}
+
+// GetMetricsTokenRotations returns the number of the bearer token rotations. If error is not nil then value is
+// undefined
+func GetMetricsTokenRotations(secretName string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := metricsTokenRotations.WithLabelValues(secretName).Write(dto)
+	value := dto.Counter.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// SetMetricsTokenExpiration sets the expiration time of the bearer token
+func SetMetricsTokenExpiration(secretName string, expiration time.Time) {
+	metricsTokenExpiration.WithLabelValues(secretName).Set(float64(expiration.Unix()))
+}
+
+// GetMetricsTokenExpiration returns the expiration time of the bearer token, in seconds since the epoch. If error is
+// not nil then value is undefined
+func GetMetricsTokenExpiration(secretName string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := metricsTokenExpiration.WithLabelValues(secretName).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
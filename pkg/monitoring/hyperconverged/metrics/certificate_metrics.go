@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"time"
+
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+const (
+	labelCertificate = "certificate"
+
+	// CertificateWebhook is the serving certificate of the webhook and of its metrics endpoint
+	CertificateWebhook = "webhook"
+	// CertificateCLIDownloadsRoute is the custom certificate of the CLI downloads route, from the cluster Ingress
+	CertificateCLIDownloadsRoute = "cli-downloads-route"
+)
+
+var (
+	certificateMetrics = []operatormetrics.Metric{
+		certificateExpiration,
+	}
+
+	certificateExpiration = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_certificate_expiration_timestamp_seconds",
+			Help: "The expiration time of a certificate that is used by HCO, by the certificate name, in seconds since the epoch",
+		},
+		[]string{labelCertificate},
+	)
+)
+
+// SetupWebhookMetrics registers only the metrics of the webhook. The webhook does not expose the rest of the operator
+// metrics.
+func SetupWebhookMetrics() error {
+	return operatormetrics.RegisterMetrics(bearerTokenMetrics, certificateMetrics)
+}
+
+// SetCertificateExpiration sets the expiration time of a certificate
+func SetCertificateExpiration(certificate string, expiration time.Time) {
+	certificateExpiration.WithLabelValues(certificate).Set(float64(expiration.Unix()))
+}
+
+// DeleteCertificateExpiration removes the expiration time of a certificate that is no longer in use
+func DeleteCertificateExpiration(certificate string) {
+	certificateExpiration.DeleteLabelValues(certificate)
+}
+
+// GetCertificateExpiration returns the expiration time of a certificate, in seconds since the epoch. If error is not
+// nil then value is undefined
+func GetCertificateExpiration(certificate string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := certificateExpiration.WithLabelValues(certificate).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"time"
+
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+const (
+	dictUpToDate    = float64(1)
+	dictNotUpToDate = float64(0)
+)
+
+var (
+	goldenImageMetrics = []operatormetrics.Metric{
+		dictLastImportTimestamp,
+		dictUpToDateStatus,
+	}
+
+	dictLastImportTimestamp = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcrontemplate_last_import_timestamp_seconds",
+			Help: "The time of the last successful import of the golden image of the DataImportCronTemplate, in seconds since the Unix epoch",
+		},
+		[]string{counterLabelDICTName, counterLabelDSName},
+	)
+
+	dictUpToDateStatus = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcrontemplate_up_to_date",
+			Help: "Indicates whether the golden image of the DataImportCronTemplate is up to date (1) or not (0)",
+		},
+		[]string{counterLabelDICTName, counterLabelDSName},
+	)
+)
+
+// SetDICTLastImportTimestamp sets the time of the last successful import of the DICT golden image
+func SetDICTLastImportTimestamp(dictName, dsName string, lastImport time.Time) {
+	dictLastImportTimestamp.WithLabelValues(getLabelsForDataImportCron(dictName, dsName)).Set(float64(lastImport.Unix()))
+}
+
+// GetDICTLastImportTimestamp returns the time of the last successful import of the DICT golden image, in seconds
+// since the Unix epoch. If error is not nil then value is undefined
+func GetDICTLastImportTimestamp(dictName, dsName string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dictLastImportTimestamp.WithLabelValues(getLabelsForDataImportCron(dictName, dsName)).Write(dto)
+	if err != nil {
+		return 0, err
+	}
+
+	return dto.Gauge.GetValue(), nil
+}
+
+// SetDICTUpToDate sets the gauge to 1 if the DICT golden image is up to date, or to 0 if it's not
+func SetDICTUpToDate(dictName, dsName string, upToDate bool) {
+	value := dictNotUpToDate
+	if upToDate {
+		value = dictUpToDate
+	}
+	dictUpToDateStatus.WithLabelValues(getLabelsForDataImportCron(dictName, dsName)).Set(value)
+}
+
+// IsDICTUpToDate returns true if the DICT golden image is up to date; else, return false
+func IsDICTUpToDate(dictName, dsName string) (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dictUpToDateStatus.WithLabelValues(getLabelsForDataImportCron(dictName, dsName)).Write(dto)
+	if err != nil {
+		return false, err
+	}
+
+	return dto.Gauge.GetValue() == dictUpToDate, nil
+}
+
+// ResetDICTImportMetrics removes all the golden image import metrics, so removed DICTs won't be reported
+func ResetDICTImportMetrics() {
+	dictLastImportTimestamp.Reset()
+	dictUpToDateStatus.Reset()
+}
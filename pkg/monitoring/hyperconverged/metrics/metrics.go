@@ -6,6 +6,10 @@ func SetupMetrics() error {
 	return operatormetrics.RegisterMetrics(
 		operatorMetrics,
 		infrastructureMetrics,
+		workloadUpdateMetrics,
+		goldenImageMetrics,
+		bearerTokenMetrics,
+		certificateMetrics,
 	)
 }
 
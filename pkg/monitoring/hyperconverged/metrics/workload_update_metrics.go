@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+const (
+	labelWorkloadUpdateState = "state"
+
+	WorkloadUpdateStatePending   = "pending"
+	WorkloadUpdateStateMigrating = "migrating"
+	WorkloadUpdateStateBlocked   = "blocked"
+
+	workloadUpdateStalledTrue  = 1.0
+	workloadUpdateStalledFalse = 0.0
+)
+
+var (
+	workloadUpdateMetrics = []operatormetrics.Metric{
+		workloadUpdateOutdatedVMIs,
+		workloadUpdateStalled,
+	}
+
+	workloadUpdateOutdatedVMIs = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_workload_update_outdated_vmis",
+			Help: "The number of VirtualMachineInstances that are running with an outdated virt-launcher, by their update state (pending, migrating or blocked)",
+		},
+		[]string{labelWorkloadUpdateState},
+	)
+
+	workloadUpdateStalled = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_workload_update_stalled",
+			Help: "Indicates whether the workload update did not progress for longer than the configured threshold (1) or not (0)",
+		},
+	)
+)
+
+// SetWorkloadUpdateOutdatedVMIs sets the number of the outdated VMIs in the given update state
+func SetWorkloadUpdateOutdatedVMIs(state string, count int) {
+	workloadUpdateOutdatedVMIs.WithLabelValues(state).Set(float64(count))
+}
+
+// GetWorkloadUpdateOutdatedVMIs returns the number of the outdated VMIs in the given update state. If error is not
+// nil then value is undefined
+func GetWorkloadUpdateOutdatedVMIs(state string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := workloadUpdateOutdatedVMIs.WithLabelValues(state).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// SetWorkloadUpdateStalled sets the gauge to 1 if the workload update is stalled, or to 0 if it's not
+func SetWorkloadUpdateStalled(stalled bool) {
+	if stalled {
+		workloadUpdateStalled.Set(workloadUpdateStalledTrue)
+	} else {
+		workloadUpdateStalled.Set(workloadUpdateStalledFalse)
+	}
+}
+
+// IsWorkloadUpdateStalled returns true if the workload update is stalled; else, return false
+func IsWorkloadUpdateStalled() (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := workloadUpdateStalled.Write(dto)
+	if err != nil {
+		return false, err
+	}
+
+	return dto.Gauge.GetValue() == workloadUpdateStalledTrue, nil
+}
@@ -14,6 +14,11 @@ const (
 	unsupportedArchitecturesAlert    = "HCOGoldenImageWithNoSupportedArchitecture"
 	dictWithNoArchAnnotationAlert    = "HCOGoldenImageWithNoArchitectureAnnotation"
 	multiArchBootImagesDisabledAlert = "HCOMultiArchGoldenImagesDisabled"
+	workloadUpdateStalledAlert       = "HCOWorkloadUpdateStalled"
+	staleGoldenImageAlert            = "HCOGoldenImageOutdated"
+	certificateExpiringAlert         = "HCOCertificateExpiringSoon"
+	customIngressCertExpiringAlert   = "HCOCustomIngressCertificateExpiringSoon"
+	caBundleExpiringAlert            = "HCOCABundleExpiringSoon"
 
 	severityAlertLabelKey     = "severity"
 	healthImpactAlertLabelKey = "operator_health_impact"
@@ -106,6 +111,67 @@ func operatorAlerts() []promv1.Rule {
 				healthImpactAlertLabelKey: "none",
 			},
 		},
+		{
+			Alert: workloadUpdateStalledAlert,
+			Expr:  intstr.FromString("kubevirt_hco_workload_update_stalled == 1"),
+			Annotations: map[string]string{
+				"description": "The update of the running VirtualMachineInstances to the current virt-launcher version did not progress for longer than the configured threshold. See the status.workloadUpdate field of the HyperConverged resource for the blocked VirtualMachineInstances.",
+				"summary":     "The workload update is stalled.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "none",
+			},
+		},
+		{
+			Alert: staleGoldenImageAlert,
+			Expr:  intstr.FromString("kubevirt_hco_dataimportcrontemplate_up_to_date == 0"),
+			For:   ptr.To(promv1.Duration("1h")),
+			Annotations: map[string]string{
+				"description": "The golden image of the {{ $labels.data_import_cron_name }} DataImportCronTemplate (for the {{ $labels.managed_data_source_name }} DataSource) is not up to date for more than one hour. See the status.dataImportCronTemplates field of the HyperConverged resource for details.",
+				"summary":     "A golden image is not up to date.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "none",
+			},
+		},
+		{
+			Alert: certificateExpiringAlert,
+			Expr:  intstr.FromString(`kubevirt_hco_certificate_expiration_timestamp_seconds{certificate!="cli-downloads-route"} - time() < 7 * 24 * 3600`),
+			Annotations: map[string]string{
+				"description": "The {{ $labels.certificate }} serving certificate expires in less than 7 days, or is already expired, and it was not rotated. Once the certificate is expired, the API server can't call the HyperConverged webhook, and Prometheus can't scrape the metrics.",
+				"summary":     "The {{ $labels.certificate }} certificate is about to expire.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "warning",
+			},
+		},
+		{
+			Alert: customIngressCertExpiringAlert,
+			Expr:  intstr.FromString(`kubevirt_hco_certificate_expiration_timestamp_seconds{certificate="cli-downloads-route"} - time() < 30 * 24 * 3600`),
+			Annotations: map[string]string{
+				"description": "The custom certificate of the virt-downloads component route, in the cluster Ingress, expires in less than 30 days, or is already expired. Replace the certificate in the serving certificate Secret before it expires.",
+				"summary":     "The custom certificate of the CLI downloads route is about to expire.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "none",
+			},
+		},
+		{
+			Alert: caBundleExpiringAlert,
+			Expr:  intstr.FromString(`kubevirt_hco_ca_bundle_expiration_timestamp_seconds - time() < 7 * 24 * 3600`),
+			Annotations: map[string]string{
+				"description": "All the certificates in the {{ $labels.configmap }} CA bundle expire in less than 7 days, or are already expired, and the CA was not rotated.",
+				"summary":     "The {{ $labels.configmap }} CA bundle is about to expire.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "warning",
+			},
+		},
 		{
 			Alert: "DeprecatedMachineType",
 			Expr: intstr.FromString(`
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+)
+
+// ParsePEMCertificates returns all the certificates in PEM encoded data. PEM blocks of other types are ignored.
+func ParsePEMCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("failed to decode certificate PEM")
+	}
+
+	return certs, nil
+}
+
+// GetCABundleExpiration returns the expiration time of a CA bundle. While a CA is rotated, the bundle holds both the
+// old and the new CA certificates, so the bundle is valid until its latest certificate expires.
+func GetCABundleExpiration(data []byte) (time.Time, error) {
+	certs, err := ParsePEMCertificates(data)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var expiration time.Time
+	for _, cert := range certs {
+		if cert.NotAfter.After(expiration) {
+			expiration = cert.NotAfter
+		}
+	}
+
+	return expiration, nil
+}
+
+// GetKeyPairExpiration returns the expiration time of the leaf certificate of a TLS key pair
+func GetKeyPairExpiration(keyPair tls.Certificate) (time.Time, error) {
+	if keyPair.Leaf != nil {
+		return keyPair.Leaf.NotAfter, nil
+	}
+
+	if len(keyPair.Certificate) == 0 {
+		return time.Time{}, errors.New("the key pair does not contain a certificate")
+	}
+
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return leaf.NotAfter, nil
+}
@@ -0,0 +1,102 @@
+package util
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test certificates", func() {
+	var (
+		oldCA  []byte
+		newCA  []byte
+		oldExp = time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		newExp = time.Now().Add(365 * 24 * time.Hour).Truncate(time.Second)
+	)
+
+	BeforeEach(func() {
+		oldCA, _ = generateTestCert(oldExp)
+		newCA, _ = generateTestCert(newExp)
+	})
+
+	Context("ParsePEMCertificates", func() {
+		It("should return all the certificates, and ignore other blocks", func() {
+			data := bytes.Join([][]byte{oldCA, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}), newCA}, nil)
+
+			certs, err := ParsePEMCertificates(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(certs).To(HaveLen(2))
+		})
+
+		It("should return error if there is no certificate", func() {
+			_, err := ParsePEMCertificates([]byte("not a certificate"))
+			Expect(err).To(MatchError("failed to decode certificate PEM"))
+		})
+	})
+
+	Context("GetCABundleExpiration", func() {
+		It("should return the expiration time of the latest certificate", func() {
+			expiration, err := GetCABundleExpiration(bytes.Join([][]byte{newCA, oldCA}, nil))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expiration).To(BeTemporally("==", newExp))
+		})
+	})
+
+	Context("GetKeyPairExpiration", func() {
+		It("should return the expiration time of the leaf certificate", func() {
+			cert, key := generateTestCert(oldExp)
+			keyPair, err := tls.X509KeyPair(cert, key)
+			Expect(err).ToNot(HaveOccurred())
+
+			expiration, err := GetKeyPairExpiration(keyPair)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expiration).To(BeTemporally("==", oldExp))
+
+			keyPair.Leaf = nil
+			expiration, err = GetKeyPairExpiration(keyPair)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expiration).To(BeTemporally("==", oldExp))
+		})
+
+		It("should return error if there is no certificate", func() {
+			_, err := GetKeyPairExpiration(tls.Certificate{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+func generateTestCert(notAfter time.Time) ([]byte, []byte) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject: pkix.Name{
+			Organization: []string{"Acme Co"},
+		},
+		NotBefore:             time.Now(),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &privKey.PublicKey, privKey)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+	privBytes, err := x509.MarshalPKCS8PrivateKey(privKey)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privBytes})
+}
@@ -8,10 +8,13 @@ import (
 
 	"github.com/go-logr/logr"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/utils/net"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,11 +32,29 @@ type ClusterInfo interface {
 	IsMonitoringAvailable() bool
 	IsDeschedulerAvailable() bool
 	IsNADAvailable() bool
+	IsMutatingAdmissionPolicyAvailable() bool
+	IsCertManagerAvailable() bool
+	IsBaselineAdminNetworkPolicyAvailable() bool
 	IsDeschedulerCRDDeployed(ctx context.Context, cl client.Client) bool
 	IsSingleStackIPv6() bool
 	IsHyperShiftManaged() bool
 	GetTLSSecurityProfile(hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *openshiftconfigv1.TLSSecurityProfile
 	RefreshAPIServerCR(ctx context.Context, c client.Client) error
+	GetClusterProxy() *ClusterProxy
+	RefreshProxyCR(ctx context.Context, c client.Client) error
+}
+
+// ClusterProxy is the cluster-wide egress proxy configuration of an OpenShift cluster, as published in the status of
+// the Proxy CR
+type ClusterProxy struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+	// TrustedCAConfigMapName is the name of the ConfigMap in the openshift-config namespace, that is referenced by
+	// the spec.trustedCA field of the Proxy CR
+	TrustedCAConfigMapName string
+	// TrustedCA is the PEM encoded CA bundle from the TrustedCAConfigMapName ConfigMap
+	TrustedCA string
 }
 
 type ClusterInfoImp struct {
@@ -44,6 +65,9 @@ type ClusterInfoImp struct {
 	monitoringAvailable        bool
 	deschedulerAvailable       bool
 	nadAvailable               bool
+	mapAvailable               bool
+	certManagerAvailable       bool
+	banpAvailable              bool
 	singlestackipv6            bool
 	isHyperShiftManaged        bool
 	baseDomain                 string
@@ -57,6 +81,8 @@ var clusterInfo ClusterInfo
 
 var validatedAPIServerTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile
 
+var clusterProxy *ClusterProxy
+
 var GetClusterInfo = func() ClusterInfo {
 	return clusterInfo
 }
@@ -92,10 +118,16 @@ func (c *ClusterInfoImp) Init(ctx context.Context, cl client.Client, logger logr
 	c.monitoringAvailable = isPrometheusExists(ctx, cl, logger)
 	c.deschedulerAvailable = isDeschedulerExists(ctx, cl, logger)
 	c.nadAvailable = isNADExists(ctx, cl, logger)
+	c.mapAvailable = isMutatingAdmissionPolicyExists(cl, logger)
+	c.certManagerAvailable = isCertManagerExists(ctx, cl, logger)
+	c.banpAvailable = isBaselineAdminNetworkPolicyExists(ctx, cl, logger)
 	c.logger.Info("addOns ",
 		"monitoring", c.monitoringAvailable,
 		"kubeDescheduler", c.deschedulerAvailable,
 		"networkAttachmentDefinition", c.nadAvailable,
+		"mutatingAdmissionPolicy", c.mapAvailable,
+		"certManager", c.certManagerAvailable,
+		"baselineAdminNetworkPolicy", c.banpAvailable,
 	)
 
 	err = c.RefreshAPIServerCR(ctx, cl)
@@ -103,6 +135,11 @@ func (c *ClusterInfoImp) Init(ctx context.Context, cl client.Client, logger logr
 		return err
 	}
 
+	err = c.RefreshProxyCR(ctx, cl)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -178,6 +215,18 @@ func (c *ClusterInfoImp) IsNADAvailable() bool {
 	return c.nadAvailable
 }
 
+func (c *ClusterInfoImp) IsMutatingAdmissionPolicyAvailable() bool {
+	return c.mapAvailable
+}
+
+func (c *ClusterInfoImp) IsCertManagerAvailable() bool {
+	return c.certManagerAvailable
+}
+
+func (c *ClusterInfoImp) IsBaselineAdminNetworkPolicyAvailable() bool {
+	return c.banpAvailable
+}
+
 func (c *ClusterInfoImp) IsDeschedulerCRDDeployed(ctx context.Context, cl client.Client) bool {
 	return isCRDExists(ctx, cl, DeschedulerCRDName, logr.FromContextOrDiscard(ctx))
 }
@@ -225,6 +274,30 @@ func isNADExists(ctx context.Context, cl client.Client, logger logr.Logger) bool
 	return isCRDExists(ctx, cl, NetworkAttachmentDefinitionCRDName, logger)
 }
 
+func isCertManagerExists(ctx context.Context, cl client.Client, logger logr.Logger) bool {
+	return isCRDExists(ctx, cl, CertManagerCertificateCRDName, logger)
+}
+
+func isBaselineAdminNetworkPolicyExists(ctx context.Context, cl client.Client, logger logr.Logger) bool {
+	return isCRDExists(ctx, cl, BaselineAdminNetworkPolicyCRDName, logger)
+}
+
+// isMutatingAdmissionPolicyExists checks if the MutatingAdmissionPolicy API is served by the cluster. The API is
+// beta, and it is not enabled by default.
+func isMutatingAdmissionPolicyExists(cl client.Client, logger logr.Logger) bool {
+	gk := schema.GroupKind{Group: admissionregistrationv1beta1.GroupName, Kind: "MutatingAdmissionPolicy"}
+	if _, err := cl.RESTMapper().RESTMapping(gk, admissionregistrationv1beta1.SchemeGroupVersion.Version); err != nil {
+		if !meta.IsNoMatchError(err) {
+			logger.Error(err, "cannot find the MutatingAdmissionPolicy API")
+		} else {
+			logger.Info("the MutatingAdmissionPolicy API is not available")
+		}
+		return false
+	}
+
+	return true
+}
+
 // IsPersesAvailable returns true when the Perses CRDs are installed in the cluster.
 func IsPersesAvailable(ctx context.Context, cl client.Client) bool {
 	logger := logr.FromContextOrDiscard(ctx)
@@ -312,6 +385,58 @@ func (c *ClusterInfoImp) RefreshAPIServerCR(ctx context.Context, cl client.Clien
 	return nil
 }
 
+// GetClusterProxy returns the cluster-wide proxy configuration, or nil if the cluster is not configured with a proxy
+func (c *ClusterInfoImp) GetClusterProxy() *ClusterProxy {
+	return clusterProxy
+}
+
+// RefreshProxyCR reads the OpenShift Proxy CR, and the ConfigMap with its trusted CA bundle
+func (c *ClusterInfoImp) RefreshProxyCR(ctx context.Context, cl client.Client) error {
+	if !c.IsOpenshift() {
+		clusterProxy = nil
+		return nil
+	}
+
+	instance := &openshiftconfigv1.Proxy{}
+	err := cl.Get(ctx, client.ObjectKey{Name: ProxyCRName}, instance)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			clusterProxy = nil
+			return nil
+		}
+		return err
+	}
+
+	proxy := &ClusterProxy{
+		HTTPProxy:              instance.Status.HTTPProxy,
+		HTTPSProxy:             instance.Status.HTTPSProxy,
+		NoProxy:                instance.Status.NoProxy,
+		TrustedCAConfigMapName: instance.Spec.TrustedCA.Name,
+	}
+
+	if proxy.TrustedCAConfigMapName != "" {
+		cm := &corev1.ConfigMap{}
+		key := client.ObjectKey{Namespace: OpenshiftConfigNamespace, Name: proxy.TrustedCAConfigMapName}
+		err = cl.Get(ctx, key, cm)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			// the ConfigMap is watched, so the proxy configuration will be refreshed when it is created
+			c.logger.Error(err, "can't find the trusted CA ConfigMap of the cluster-wide proxy", "name", proxy.TrustedCAConfigMapName)
+		} else {
+			proxy.TrustedCA = cm.Data[ProxyTrustedCABundleKey]
+		}
+	}
+
+	if *proxy == (ClusterProxy{}) {
+		proxy = nil
+	}
+
+	clusterProxy = proxy
+	return nil
+}
+
 func (c *ClusterInfoImp) validateAPIServerTLSSecurityProfile(apiServerTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *openshiftconfigv1.TLSSecurityProfile {
 	if apiServerTLSSecurityProfile == nil || apiServerTLSSecurityProfile.Type != openshiftconfigv1.TLSProfileCustomType {
 		return apiServerTLSSecurityProfile
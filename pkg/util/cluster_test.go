@@ -862,4 +862,93 @@ var _ = Describe("test clusterInfo", func() {
 			Expect(GetClusterInfo().IsDeschedulerCRDDeployed(context.TODO(), cl)).To(BeTrue(), "should now return true for IsDeschedulerCRDDeployed(...)")
 		})
 	})
+
+	Context("cluster-wide proxy", func() {
+		const trustedCA = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+		newProxy := func() *openshiftconfigv1.Proxy {
+			return &openshiftconfigv1.Proxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ProxyCRName,
+				},
+				Spec: openshiftconfigv1.ProxySpec{
+					TrustedCA: openshiftconfigv1.ConfigMapNameReference{Name: "user-ca-bundle"},
+				},
+				Status: openshiftconfigv1.ProxyStatus{
+					HTTPProxy:  "http://proxy.example.com:3128",
+					HTTPSProxy: "http://proxy.example.com:3128",
+					NoProxy:    ".cluster.local,.svc",
+				},
+			}
+		}
+
+		trustedCACM := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "user-ca-bundle",
+				Namespace: OpenshiftConfigNamespace,
+			},
+			Data: map[string]string{
+				ProxyTrustedCABundleKey: trustedCA,
+			},
+		}
+
+		It("should not return a proxy if the cluster has no Proxy CR", func() {
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithRuntimeObjects(clusterVersion, infrastructure, ingress, apiServer, dns, ipv4network).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+			Expect(GetClusterInfo().GetClusterProxy()).To(BeNil())
+		})
+
+		It("should not return a proxy if the cluster is not configured with a proxy", func() {
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithRuntimeObjects(clusterVersion, infrastructure, ingress, apiServer, dns, ipv4network, &openshiftconfigv1.Proxy{ObjectMeta: metav1.ObjectMeta{Name: ProxyCRName}}).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+			Expect(GetClusterInfo().GetClusterProxy()).To(BeNil())
+		})
+
+		It("should read the proxy and its trusted CA, and refresh them on changes", func() {
+			proxy := newProxy()
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithRuntimeObjects(clusterVersion, infrastructure, ingress, apiServer, dns, ipv4network, proxy).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+			By("the trusted CA ConfigMap does not exist yet")
+			Expect(GetClusterInfo().GetClusterProxy()).To(Equal(&ClusterProxy{
+				HTTPProxy:              "http://proxy.example.com:3128",
+				HTTPSProxy:             "http://proxy.example.com:3128",
+				NoProxy:                ".cluster.local,.svc",
+				TrustedCAConfigMapName: "user-ca-bundle",
+			}))
+
+			Expect(cl.Create(context.TODO(), trustedCACM.DeepCopy())).To(Succeed())
+			Expect(GetClusterInfo().RefreshProxyCR(context.TODO(), cl)).To(Succeed())
+			Expect(GetClusterInfo().GetClusterProxy().TrustedCA).To(Equal(trustedCA))
+
+			proxy.Status.HTTPProxy = "http://other-proxy.example.com:3128"
+			Expect(cl.Update(context.TODO(), proxy)).To(Succeed())
+			Expect(GetClusterInfo().GetClusterProxy().HTTPProxy).To(Equal("http://proxy.example.com:3128"), "should still return the cached value")
+
+			Expect(GetClusterInfo().RefreshProxyCR(context.TODO(), cl)).To(Succeed())
+			Expect(GetClusterInfo().GetClusterProxy().HTTPProxy).To(Equal("http://other-proxy.example.com:3128"))
+		})
+
+		It("should ignore the Proxy CR on kubernetes", func() {
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithRuntimeObjects(newProxy(), trustedCACM.DeepCopy()).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+			Expect(GetClusterInfo().IsOpenshift()).To(BeFalse())
+			Expect(GetClusterInfo().GetClusterProxy()).To(BeNil())
+		})
+	})
 })
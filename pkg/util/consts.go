@@ -30,6 +30,8 @@ const (
 	PasstImageEnvV                     = "PASST_SIDECAR_IMAGE"
 	PasstCNIImageEnvV                  = "PASST_CNI_IMAGE"
 	WaspAgentImageEnvV                 = "WASP_AGENT_IMAGE"
+	OperatorImageEnvV                  = "OPERATOR_IMAGE"
+	VirtioWinImageEnvV                 = "VIRTIOWIN_CONTAINER"
 	DeployNetworkPoliciesEnvV          = "DEPLOY_NETWORK_POLICIES"
 	HcoValidatingWebhook               = "validate-hco.kubevirt.io"
 	HcoMutatingWebhookNS               = "mutate-ns-hco.kubevirt.io"
@@ -39,6 +41,8 @@ const (
 	PersesDashboardsCRDName            = "persesdashboards.perses.dev"
 	PersesDatasourcesCRDName           = "persesdatasources.perses.dev"
 	NetworkAttachmentDefinitionCRDName = "network-attachment-definitions.k8s.cni.cncf.io"
+	CertManagerCertificateCRDName      = "certificates.cert-manager.io"
+	BaselineAdminNetworkPolicyCRDName  = "baselineadminnetworkpolicies.policy.networking.k8s.io"
 	HcoMutatingWebhookHyperConverged   = "mutate-hyperconverged-hco.kubevirt.io"
 	AppLabel                           = "app"
 	UndefinedNamespace                 = ""
@@ -57,6 +61,10 @@ const (
 	AppLabelComponent = AppLabelPrefix + "/component"
 	// Operator name for managed-by label
 	OperatorName = "hco-operator"
+	// SSPOperatorName is the value of the managed-by label of the resources that SSP creates
+	SSPOperatorName = "ssp-operator"
+	// DataImportCronLabel is the label that CDI sets on the DataSources that are managed by a DataImportCron
+	DataImportCronLabel = "cdi.kubevirt.io/dataImportCron"
 	// Value for "part-of" label
 	HyperConvergedCluster    = "hyperconverged-cluster"
 	OpenshiftNodeSelectorAnn = "openshift.io/node-selector"
@@ -89,13 +97,23 @@ const (
 	UIProxyServerPort      int32 = 8080
 
 	APIServerCRName      = "cluster"
+	ProxyCRName          = "cluster"
 	DeschedulerCRName    = "cluster"
 	DeschedulerNamespace = "openshift-kube-descheduler-operator"
 
+	// OpenshiftConfigNamespace is the namespace of the user provided cluster configuration objects, like the trusted
+	// CA ConfigMap of the cluster-wide proxy
+	OpenshiftConfigNamespace = "openshift-config"
+	// ProxyTrustedCABundleKey is the key of the CA bundle in the trusted CA ConfigMap of the cluster-wide proxy
+	ProxyTrustedCABundleKey = "ca-bundle.crt"
+
 	DataImportCronEnabledAnnotation = "dataimportcrontemplate.kubevirt.io/enable"
 
 	HCOAnnotationPrefix = "hco.kubevirt.io/"
-	NPLabelPrefix       = "np.kubevirt.io/"
+	// ConfigHashAnnotation is set on the pod templates of the deployments that must be restarted when their
+	// configuration is modified
+	ConfigHashAnnotation = HCOAnnotationPrefix + "configHash"
+	NPLabelPrefix        = "np.kubevirt.io/"
 
 	// AllowEgressToDNSAndAPIServerLabel if this label is set, the network policy will allow egress to DNS and API server
 	AllowEgressToDNSAndAPIServerLabel = NPLabelPrefix + "allow-access-cluster-services"
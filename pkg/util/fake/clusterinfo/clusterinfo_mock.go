@@ -77,6 +77,18 @@ func (ClusterInfoMock) IsNADAvailable() bool {
 	return true
 }
 
+func (ClusterInfoMock) IsMutatingAdmissionPolicyAvailable() bool {
+	return true
+}
+
+func (ClusterInfoMock) IsCertManagerAvailable() bool {
+	return true
+}
+
+func (ClusterInfoMock) IsBaselineAdminNetworkPolicyAvailable() bool {
+	return true
+}
+
 func (ClusterInfoMock) IsDeschedulerCRDDeployed(ctx context.Context, cl client.Client) bool {
 	return true
 }
@@ -92,3 +104,11 @@ func (ClusterInfoMock) GetTLSSecurityProfile(_ *openshiftconfigv1.TLSSecurityPro
 func (ClusterInfoMock) RefreshAPIServerCR(_ context.Context, _ client.Client) error {
 	return nil
 }
+
+func (ClusterInfoMock) GetClusterProxy() *hcoutil.ClusterProxy {
+	return nil
+}
+
+func (ClusterInfoMock) RefreshProxyCR(_ context.Context, _ client.Client) error {
+	return nil
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+)
+
+// GetTLSProfileSpec returns the ciphers and the minimal TLS version of a TLS security profile. A predefined profile
+// (Old, Intermediate or Modern) is resolved by its type, even if its matching field is not set.
+//
+// The TLS 1.3 cipher suites are not configurable, so no cipher is returned if the minimal TLS version is VersionTLS13.
+func GetTLSProfileSpec(profile *openshiftconfigv1.TLSSecurityProfile) openshiftconfigv1.TLSProfileSpec {
+	var spec openshiftconfigv1.TLSProfileSpec
+
+	switch {
+	case profile == nil:
+		spec = *openshiftconfigv1.TLSProfiles[openshiftconfigv1.TLSProfileIntermediateType].DeepCopy()
+	case profile.Custom != nil:
+		spec = *profile.Custom.TLSProfileSpec.DeepCopy()
+	default:
+		predefined, ok := openshiftconfigv1.TLSProfiles[profile.Type]
+		if !ok {
+			predefined = openshiftconfigv1.TLSProfiles[openshiftconfigv1.TLSProfileIntermediateType]
+		}
+		spec = *predefined.DeepCopy()
+	}
+
+	if spec.MinTLSVersion == openshiftconfigv1.VersionTLS13 {
+		spec.Ciphers = nil
+	}
+
+	return spec
+}
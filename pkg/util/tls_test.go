@@ -0,0 +1,41 @@
+package util
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+)
+
+var _ = Describe("Test GetTLSProfileSpec", func() {
+	It("should return the intermediate profile if the profile is not set", func() {
+		spec := GetTLSProfileSpec(nil)
+		Expect(spec.MinTLSVersion).To(Equal(openshiftconfigv1.VersionTLS12))
+		Expect(spec.Ciphers).To(Equal(openshiftconfigv1.TLSProfiles[openshiftconfigv1.TLSProfileIntermediateType].Ciphers))
+	})
+
+	It("should resolve a predefined profile by its type", func() {
+		spec := GetTLSProfileSpec(&openshiftconfigv1.TLSSecurityProfile{Type: openshiftconfigv1.TLSProfileOldType})
+		Expect(spec.MinTLSVersion).To(Equal(openshiftconfigv1.VersionTLS10))
+		Expect(spec.Ciphers).To(Equal(openshiftconfigv1.TLSProfiles[openshiftconfigv1.TLSProfileOldType].Ciphers))
+	})
+
+	It("should not return any cipher for the modern profile", func() {
+		spec := GetTLSProfileSpec(&openshiftconfigv1.TLSSecurityProfile{Type: openshiftconfigv1.TLSProfileModernType})
+		Expect(spec.MinTLSVersion).To(Equal(openshiftconfigv1.VersionTLS13))
+		Expect(spec.Ciphers).To(BeEmpty())
+	})
+
+	It("should return the custom profile", func() {
+		spec := GetTLSProfileSpec(&openshiftconfigv1.TLSSecurityProfile{
+			Type: openshiftconfigv1.TLSProfileCustomType,
+			Custom: &openshiftconfigv1.CustomTLSProfile{
+				TLSProfileSpec: openshiftconfigv1.TLSProfileSpec{
+					Ciphers:       []string{"ECDHE-RSA-AES128-GCM-SHA256"},
+					MinTLSVersion: openshiftconfigv1.VersionTLS11,
+				},
+			},
+		})
+		Expect(spec.MinTLSVersion).To(Equal(openshiftconfigv1.VersionTLS11))
+		Expect(spec.Ciphers).To(Equal([]string{"ECDHE-RSA-AES128-GCM-SHA256"}))
+	})
+})
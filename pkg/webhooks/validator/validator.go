@@ -1,34 +1,45 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"path"
 	"reflect"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	"github.com/samber/lo"
-	xsync "golang.org/x/sync/errgroup"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+	"k8s.io/utils/ptr"
 	"k8s.io/utils/strings/slices"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	migrationv1alpha1 "kubevirt.io/kubevirt-migration-operator/api/v1alpha1"
 	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -58,6 +69,7 @@ func (v *ValidationWarning) Warnings() []string {
 type WebhookHandler struct {
 	logger      logr.Logger
 	cli         client.Client
+	apiReader   client.Reader
 	namespace   string
 	isOpenshift bool
 	decoder     admission.Decoder
@@ -65,11 +77,12 @@ type WebhookHandler struct {
 
 var hcoTLSConfigCache *openshiftconfigv1.TLSSecurityProfile
 
-func NewWebhookHandler(logger logr.Logger, cli client.Client, decoder admission.Decoder, namespace string, isOpenshift bool, hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *WebhookHandler {
+func NewWebhookHandler(logger logr.Logger, cli client.Client, apiReader client.Reader, decoder admission.Decoder, namespace string, isOpenshift bool, hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *WebhookHandler {
 	hcoTLSConfigCache = hcoTLSSecurityProfile
 	return &WebhookHandler{
 		logger:      logger,
 		cli:         cli,
+		apiReader:   apiReader,
 		namespace:   namespace,
 		isOpenshift: isOpenshift,
 		decoder:     decoder,
@@ -117,6 +130,13 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 
 	// Check the error message first.
 	if err != nil {
+		// the message of aggregated errors is the message of all the errors; the API status of the first one is
+		// not enough
+		var aggregated interface{ Unwrap() []error }
+		if errors.As(err, &aggregated) {
+			return admission.Denied(err.Error())
+		}
+
 		var apiStatus apierrors.APIStatus
 		if errors.As(err, &apiStatus) {
 			return validationResponseFromStatus(false, apiStatus.Status())
@@ -134,7 +154,7 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 	return admission.Allowed("")
 }
 
-func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
+func (wh *WebhookHandler) ValidateCreate(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating create", "name", hc.Name, "namespace:", hc.Namespace)
 
 	if err := wh.validateCertConfig(hc); err != nil {
@@ -153,31 +173,43 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		return err
 	}
 
-	if err := wh.validateFeatureGatesOnCreate(hc); err != nil {
+	if err := wh.validatePermittedHostDevices(hc); err != nil {
 		return err
 	}
 
-	if err := wh.validateTuningPolicy(hc); err != nil {
+	if err := wh.validateArchitectureConfiguration(hc); err != nil {
 		return err
 	}
 
-	if err := wh.validateAffinity(hc); err != nil {
+	if err := wh.validateNetworking(hc); err != nil {
+		return err
+	}
+
+	if err := validateKubeMacPoolConfiguration(hc); err != nil {
 		return err
 	}
 
-	if _, err := handlers.NewKubeVirt(hc); err != nil {
+	if err := validateKubeSecondaryDNS(hc, nil); err != nil {
 		return err
 	}
 
-	if _, err := handlers.NewCDI(hc); err != nil {
+	if err := validateNetworkPolicies(hc); err != nil {
 		return err
 	}
 
-	if _, err := handlers.NewNetworkAddons(hc); err != nil {
+	if err := validateBaselineAdminNetworkPolicy(hc); err != nil {
 		return err
 	}
 
-	if _, _, err := handlers.NewSSP(hc); err != nil {
+	if err := validateImportProxy(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateAffinity(hc); err != nil {
+		return err
+	}
+
+	if _, err := wh.getOperands(hc, true); err != nil {
 		return err
 	}
 
@@ -185,30 +217,65 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		hcoTLSConfigCache = hc.Spec.TLSSecurityProfile
 	}
 
-	return nil
+	// a warning ends the validation, so it must be the last check
+	warnings := append(featuregates.WarningsOnCreate(&hc.Spec.FeatureGates), warnTuningPolicy(hc)...)
+	return wh.warn(ctx, hc, warnings)
+}
+
+// operandCR is an operand custom resource, rendered from the HyperConverged CR. Optional operands may not exist yet,
+// so they are validated with a dry-run create instead of a dry-run update.
+type operandCR struct {
+	required client.Object
+	optional bool
 }
 
-func (wh *WebhookHandler) getOperands(requested *v1beta1.HyperConverged) (*kubevirtcorev1.KubeVirt, *cdiv1beta1.CDI, *networkaddonsv1.NetworkAddonsConfig, error) {
+// getOperands renders the custom resources of all the operands that HCO deploys for the HyperConverged CR. All the
+// rendering errors are returned together.
+func (wh *WebhookHandler) getOperands(requested *v1beta1.HyperConverged, withSSP bool) ([]operandCR, error) {
 	if err := wh.validateCertConfig(requested); err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
 
-	kv, err := handlers.NewKubeVirt(requested)
-	if err != nil {
-		return nil, nil, nil, err
+	var (
+		operandCRs []operandCR
+		errs       []error
+	)
+
+	addOperand := func(obj client.Object, optional bool, err error) {
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		operandCRs = append(operandCRs, operandCR{required: obj, optional: optional})
 	}
 
+	kv, err := handlers.NewKubeVirt(requested)
+	addOperand(kv, false, err)
+
 	cdi, err := handlers.NewCDI(requested)
-	if err != nil {
-		return nil, nil, nil, err
-	}
+	addOperand(cdi, false, err)
 
 	cna, err := handlers.NewNetworkAddons(requested)
-	if err != nil {
-		return nil, nil, nil, err
+	addOperand(cna, false, err)
+
+	migController, err := handlers.NewMigController(requested)
+	addOperand(migController, true, err)
+
+	if ptr.Deref(requested.Spec.EnableApplicationAwareQuota, false) {
+		aaq, err := handlers.NewAAQ(requested)
+		addOperand(aaq, true, err)
 	}
 
-	return kv, cdi, cna, nil
+	if withSSP {
+		ssp, _, err := handlers.NewSSP(requested)
+		addOperand(ssp, false, err)
+	}
+
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
+
+	return operandCRs, nil
 }
 
 // ValidateUpdate is the ValidateUpdate webhook implementation. It calls all the resources in parallel, to dry-run the
@@ -228,39 +295,61 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		return err
 	}
 
-	if err := wh.validateFeatureGatesOnUpdate(requested, exists); err != nil {
+	// the permitted host devices were not validated in older versions; don't block the updates of an existing CR
+	// with such values, unless they are modified
+	if !reflect.DeepEqual(requested.Spec.PermittedHostDevices, exists.Spec.PermittedHostDevices) {
+		if err := wh.validatePermittedHostDevices(requested); err != nil {
+			return err
+		}
+	}
+
+	if err := wh.validateArchitectureConfiguration(requested); err != nil {
 		return err
 	}
 
-	if err := wh.validateTuningPolicy(requested); err != nil {
+	if !reflect.DeepEqual(requested.Spec.Networking, exists.Spec.Networking) {
+		if err := wh.validateNetworking(requested); err != nil {
+			return err
+		}
+	}
+
+	// the KubeMacPool range was not validated in older versions; don't block the updates of an existing CR with such a
+	// range, unless it is modified
+	if !reflect.DeepEqual(requested.Spec.KubeMacPoolConfiguration, exists.Spec.KubeMacPoolConfiguration) {
+		if err := validateKubeMacPoolConfiguration(requested); err != nil {
+			return err
+		}
+	}
+
+	if err := validateKubeSecondaryDNS(requested, exists); err != nil {
 		return err
 	}
 
-	if err := wh.validateAffinity(requested); err != nil {
+	if err := validateNetworkPolicies(requested); err != nil {
 		return err
 	}
 
-	// If no change is detected in the spec nor the annotations - nothing to validate
-	if reflect.DeepEqual(exists.Spec, requested.Spec) &&
-		reflect.DeepEqual(exists.Annotations, requested.Annotations) {
-		return nil
+	if err := validateBaselineAdminNetworkPolicy(requested); err != nil {
+		return err
 	}
 
-	kv, cdi, cna, err := wh.getOperands(requested)
-	if err != nil {
+	if err := validateImportProxy(requested); err != nil {
 		return err
 	}
 
-	toCtx, cancel := context.WithTimeout(ctx, updateDryRunTimeOut)
-	defer cancel()
+	if err := wh.validateAffinity(requested); err != nil {
+		return err
+	}
 
-	eg, egCtx := xsync.WithContext(toCtx)
-	opts := &client.UpdateOptions{DryRun: []string{metav1.DryRunAll}}
+	warnings := append(featuregates.WarningsOnUpdate(&requested.Spec.FeatureGates, &exists.Spec.FeatureGates), warnTuningPolicy(requested)...)
 
-	resources := []client.Object{
-		kv,
-		cdi,
-		cna,
+	// If no change is detected in the spec nor the annotations - nothing to validate
+	if reflect.DeepEqual(exists.Spec, requested.Spec) &&
+		reflect.DeepEqual(exists.Annotations, requested.Annotations) {
+		if len(warnings) > 0 {
+			return newValidationWarning(warnings)
+		}
+		return nil
 	}
 
 	if wh.isOpenshift {
@@ -277,24 +366,14 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		nodeinfo.GetWorkloadsArchitectures = func() []string {
 			return requested.Status.NodeInfo.WorkloadsArchitectures
 		}
-
-		ssp, _, err := handlers.NewSSP(requested)
-		if err != nil {
-			return err
-		}
-		resources = append(resources, ssp)
 	}
 
-	for _, obj := range resources {
-		func(o client.Object) {
-			eg.Go(func() error {
-				return wh.updateOperatorCr(egCtx, requested, o, opts)
-			})
-		}(obj)
+	operandCRs, err := wh.getOperands(requested, wh.isOpenshift)
+	if err != nil {
+		return err
 	}
 
-	err = eg.Wait()
-	if err != nil {
+	if err = wh.dryRunOperatorCrs(ctx, operandCRs); err != nil {
 		return err
 	}
 
@@ -302,55 +381,106 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		hcoTLSConfigCache = requested.Spec.TLSSecurityProfile
 	}
 
-	return nil
+	// a warning ends the validation, so it must be the last check
+	return wh.warn(ctx, requested, warnings)
 }
 
-func (wh *WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.HyperConverged, exists client.Object, opts *client.UpdateOptions) error {
+// dryRunOperatorCrs dry-runs the update of all the operand CRs in parallel, and returns all the errors together, so
+// the user gets all of them in one admission response.
+func (wh *WebhookHandler) dryRunOperatorCrs(ctx context.Context, operandCRs []operandCR) error {
+	toCtx, cancel := context.WithTimeout(ctx, updateDryRunTimeOut)
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs []error
+	)
+
+	for _, operand := range operandCRs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := wh.dryRunOperatorCr(toCtx, operand); err != nil {
+				lock.Lock()
+				defer lock.Unlock()
+				errs = append(errs, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return joinErrors(errs)
+}
+
+func (wh *WebhookHandler) dryRunOperatorCr(ctx context.Context, operand operandCR) error {
+	required := operand.required
+	kind := reflect.TypeOf(required).Elem().Name()
+
+	exists := reflect.New(reflect.TypeOf(required).Elem()).Interface().(client.Object)
+	exists.SetName(required.GetName())
+	exists.SetNamespace(required.GetNamespace())
+
 	err := hcoutil.GetRuntimeObject(ctx, wh.cli, exists)
 	if err != nil {
-		wh.logger.Error(err, "failed to get object from kubernetes", "kind", exists.GetObjectKind())
+		if operand.optional && apierrors.IsNotFound(err) {
+			return wh.dryRunCreateOperatorCr(ctx, required, kind)
+		}
+
+		wh.logger.Error(err, "failed to get object from kubernetes", "kind", kind)
 		return err
 	}
 
 	switch existing := exists.(type) {
 	case *kubevirtcorev1.KubeVirt:
-		required, err := handlers.NewKubeVirt(hc)
-		if err != nil {
-			return err
-		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+		required.(*kubevirtcorev1.KubeVirt).Spec.DeepCopyInto(&existing.Spec)
 
 	case *cdiv1beta1.CDI:
-		required, err := handlers.NewCDI(hc)
-		if err != nil {
-			return err
-		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+		required.(*cdiv1beta1.CDI).Spec.DeepCopyInto(&existing.Spec)
 
 	case *networkaddonsv1.NetworkAddonsConfig:
-		required, err := handlers.NewNetworkAddons(hc)
-		if err != nil {
-			return err
-		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+		required.(*networkaddonsv1.NetworkAddonsConfig).Spec.DeepCopyInto(&existing.Spec)
 
 	case *sspv1beta3.SSP:
-		required, _, err := handlers.NewSSP(hc)
-		if err != nil {
-			return err
-		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+		required.(*sspv1beta3.SSP).Spec.DeepCopyInto(&existing.Spec)
+
+	case *aaqv1alpha1.AAQ:
+		required.(*aaqv1alpha1.AAQ).Spec.DeepCopyInto(&existing.Spec)
+
+	case *migrationv1alpha1.MigController:
+		required.(*migrationv1alpha1.MigController).Spec.DeepCopyInto(&existing.Spec)
 	}
 
-	if err = wh.cli.Update(ctx, exists, opts); err != nil {
-		wh.logger.Error(err, "failed to dry-run update the object", "kind", exists.GetObjectKind())
-		return err
+	if err = wh.cli.Update(ctx, exists, &client.UpdateOptions{DryRun: []string{metav1.DryRunAll}}); err != nil {
+		wh.logger.Error(err, "failed to dry-run update the object", "kind", kind)
+		return fmt.Errorf("%s: %w", kind, err)
+	}
+
+	wh.logger.Info("dry-run update the object passed", "kind", kind)
+	return nil
+}
+
+func (wh *WebhookHandler) dryRunCreateOperatorCr(ctx context.Context, required client.Object, kind string) error {
+	// never modify the rendered object
+	obj := required.DeepCopyObject().(client.Object)
+	if err := wh.cli.Create(ctx, obj, &client.CreateOptions{DryRun: []string{metav1.DryRunAll}}); err != nil {
+		wh.logger.Error(err, "failed to dry-run create the object", "kind", kind)
+		return fmt.Errorf("%s: %w", kind, err)
 	}
 
-	wh.logger.Info("dry-run update the object passed", "kind", exists.GetObjectKind())
+	wh.logger.Info("dry-run create the object passed", "kind", kind)
 	return nil
 }
 
+// joinErrors keeps a single error as is, so the admission response uses its API status, if there is one
+func joinErrors(errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
 func (wh *WebhookHandler) ValidateDelete(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating delete", "name", hc.Name, "namespace", hc.Namespace)
 
@@ -425,7 +555,15 @@ func (wh *WebhookHandler) validateDataImportCronTemplates(hc *v1beta1.HyperConve
 func (wh *WebhookHandler) validateTLSSecurityProfiles(hc *v1beta1.HyperConverged) error {
 	tlsSP := hc.Spec.TLSSecurityProfile
 
-	if tlsSP == nil || tlsSP.Custom == nil {
+	if tlsSP == nil {
+		return nil
+	}
+
+	if tlsSP.Type == openshiftconfigv1.TLSProfileCustomType && tlsSP.Custom == nil {
+		return fmt.Errorf("spec.tlsSecurityProfile.custom is required when spec.tlsSecurityProfile.type is Custom")
+	}
+
+	if tlsSP.Custom == nil {
 		return nil
 	}
 
@@ -457,32 +595,103 @@ func (wh *WebhookHandler) validateMediatedDeviceTypes(hc *v1beta1.HyperConverged
 	return nil
 }
 
-func (wh *WebhookHandler) validateTuningPolicy(hc *v1beta1.HyperConverged) error {
-	if hc.Spec.TuningPolicy == v1beta1.HyperConvergedHighBurstProfile { //nolint SA1019
-		return newValidationWarning([]string{"spec.tuningPolicy: the highBurst profile is deprecated as of v1.16.0 and will be removed in a future release"})
-	}
-	return nil
-}
+var (
+	pciDeviceSelectorRegex = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{4}$`)
+	usbIDRegex             = regexp.MustCompile(`^[0-9a-fA-F]{4}$`)
+)
 
 const (
-	fgMovedWarning       = "spec.featureGates.%[1]s is deprecated and ignored. It will removed in a future version; use spec.%[1]s instead"
-	fgDeprecationWarning = "spec.featureGates.%s is deprecated and ignored. It will be removed in a future version;"
+	permittedHostDevicesPath = "spec.permittedHostDevices"
+	nodeListPageSize         = 100
 )
 
-func (wh *WebhookHandler) validateFeatureGatesOnCreate(hc *v1beta1.HyperConverged) error {
-	warnings := wh.validateDeprecatedFeatureGates(hc)
-	warnings = validateOldFGOnCreate(warnings, hc)
+// validatePermittedHostDevices checks the permitted host devices before they are passed to KubeVirt, that accepts
+// them without any check. All the errors are reported together.
+func (wh *WebhookHandler) validatePermittedHostDevices(hc *v1beta1.HyperConverged) error {
+	phd := hc.Spec.PermittedHostDevices
+	if phd == nil {
+		return nil
+	}
+
+	var errs []error
+	// the list of each enabled resource name; a resource name may be used by several devices in the same list
+	resourceLists := make(map[string]string)
+	checkResourceName := func(path, list, resourceName string, disabled bool) {
+		if msgs := validateHostDeviceResourceName(resourceName); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("%s.resourceName: invalid resource name %q: %s", path, resourceName, strings.Join(msgs, "; ")))
+			return
+		}
 
-	if len(warnings) > 0 {
-		return newValidationWarning(warnings)
+		if disabled {
+			return
+		}
+
+		if otherList, found := resourceLists[resourceName]; found && otherList != list {
+			errs = append(errs, fmt.Errorf("%s.resourceName: the %q resource name is already used in %s.%s", path, resourceName, permittedHostDevicesPath, otherList))
+			return
+		}
+		resourceLists[resourceName] = list
 	}
 
-	return nil
+	for i, dev := range phd.PciHostDevices {
+		path := fmt.Sprintf("%s.pciHostDevices[%d]", permittedHostDevicesPath, i)
+		if !pciDeviceSelectorRegex.MatchString(dev.PCIDeviceSelector) {
+			errs = append(errs, fmt.Errorf("%s.pciDeviceSelector: %q must be in the vendor_id:product_id format, with 4 hex digits each, e.g. 10de:1eb8", path, dev.PCIDeviceSelector))
+		}
+		checkResourceName(path, "pciHostDevices", dev.ResourceName, dev.Disabled)
+	}
+
+	for i, dev := range phd.USBHostDevices {
+		path := fmt.Sprintf("%s.usbHostDevices[%d]", permittedHostDevicesPath, i)
+		if len(dev.Selectors) == 0 {
+			errs = append(errs, fmt.Errorf("%s.selectors: at least one selector is required", path))
+		}
+		for j, selector := range dev.Selectors {
+			if !usbIDRegex.MatchString(selector.Vendor) {
+				errs = append(errs, fmt.Errorf("%s.selectors[%d].vendor: %q must be 4 hex digits, e.g. 046d", path, j, selector.Vendor))
+			}
+			if !usbIDRegex.MatchString(selector.Product) {
+				errs = append(errs, fmt.Errorf("%s.selectors[%d].product: %q must be 4 hex digits, e.g. 0825", path, j, selector.Product))
+			}
+		}
+		checkResourceName(path, "usbHostDevices", dev.ResourceName, dev.Disabled)
+	}
+
+	for i, dev := range phd.MediatedDevices {
+		path := fmt.Sprintf("%s.mediatedDevices[%d]", permittedHostDevicesPath, i)
+		if strings.TrimSpace(dev.MDEVNameSelector) == "" {
+			errs = append(errs, fmt.Errorf("%s.mdevNameSelector: must not be empty", path))
+		}
+		checkResourceName(path, "mediatedDevices", dev.ResourceName, dev.Disabled)
+	}
+
+	return errors.Join(errs...)
 }
 
-func (wh *WebhookHandler) validateFeatureGatesOnUpdate(requested, exists *v1beta1.HyperConverged) error {
-	warnings := wh.validateDeprecatedFeatureGates(requested)
-	warnings = validateOldFGOnUpdate(warnings, requested, exists)
+// validateHostDeviceResourceName checks that the resource name is a valid extended resource name, as required by the
+// kubelet device plugins; i.e. a qualified name with a domain prefix, like nvidia.com/GP102GL_Tesla_P40.
+func validateHostDeviceResourceName(resourceName string) []string {
+	if !strings.Contains(resourceName, "/") {
+		return []string{"must have a domain prefix, e.g. vendor.com/device"}
+	}
+
+	if strings.HasPrefix(resourceName, corev1.ResourceDefaultNamespacePrefix) {
+		return []string{"the " + corev1.ResourceDefaultNamespacePrefix + " domain is reserved for native resources"}
+	}
+
+	return validation.IsQualifiedName(resourceName)
+}
+
+// warn returns the best-effort warnings about configurations that are valid, but may not work in the current cluster,
+// together with the warnings that were accumulated during the validation; e.g. about ignored feature gates.
+func (wh *WebhookHandler) warn(ctx context.Context, hc *v1beta1.HyperConverged, warnings []string) error {
+	warnings = append(warnings, wh.warnUnadvertisedHostDevices(ctx, hc)...)
+	warnings = append(warnings, warnMissingArchitectures(hc)...)
+	warnings = append(warnings, warnNetworkBindingPlugins(hc)...)
+
+	if reason, message := handlers.GetIgnoredCertIssuerRefReason(hc, hcoutil.GetClusterInfo()); reason != "" {
+		warnings = append(warnings, message)
+	}
 
 	if len(warnings) > 0 {
 		return newValidationWarning(warnings)
@@ -491,98 +700,427 @@ func (wh *WebhookHandler) validateFeatureGatesOnUpdate(requested, exists *v1beta
 	return nil
 }
 
-func (wh *WebhookHandler) validateDeprecatedFeatureGates(hc *v1beta1.HyperConverged) []string {
+// warnUnadvertisedHostDevices warns about enabled permitted host devices that no node currently advertises as an
+// allocatable resource. This is not an error: the device may be added to a node later, and KubeVirt only starts to
+// advertise the devices after the permitted host devices are applied.
+func (wh *WebhookHandler) warnUnadvertisedHostDevices(ctx context.Context, hc *v1beta1.HyperConverged) []string {
+	phd := hc.Spec.PermittedHostDevices
+	if phd == nil {
+		return nil
+	}
+
+	var resourceNames []string
+	for _, dev := range phd.PciHostDevices {
+		if !dev.Disabled {
+			resourceNames = append(resourceNames, dev.ResourceName)
+		}
+	}
+	for _, dev := range phd.USBHostDevices {
+		if !dev.Disabled {
+			resourceNames = append(resourceNames, dev.ResourceName)
+		}
+	}
+	for _, dev := range phd.MediatedDevices {
+		if !dev.Disabled {
+			resourceNames = append(resourceNames, dev.ResourceName)
+		}
+	}
+
+	if len(resourceNames) == 0 {
+		return nil
+	}
+
+	advertised, err := wh.getAdvertisedResources(ctx)
+	if err != nil {
+		// the warnings are best-effort; never block the request because of them
+		wh.logger.Error(err, "can't list the nodes to check the permitted host devices")
+		return nil
+	}
+
 	var warnings []string
+	for _, resourceName := range lo.Uniq(resourceNames) {
+		if !advertised[corev1.ResourceName(resourceName)] {
+			warnings = append(warnings, fmt.Sprintf("%s: no node currently advertises the %q resource; virtual machines requesting it will not be scheduled until a node provides a matching device", permittedHostDevicesPath, resourceName))
+		}
+	}
+
+	return warnings
+}
+
+const architectureConfigurationPath = "spec.architectureConfiguration"
 
-	//nolint:staticcheck
-	if hc.Spec.FeatureGates.WithHostPassthroughCPU != nil {
-		warnings = append(warnings, fmt.Sprintf(fgDeprecationWarning, "withHostPassthroughCPU"))
+// getAdvertisedResources returns the allocatable resources of all the nodes. The nodes are read directly from the API
+// server, page by page, to avoid a cluster-wide node informer in the webhook, for a check that only runs when the
+// HyperConverged CR is modified.
+func (wh *WebhookHandler) getAdvertisedResources(ctx context.Context) (map[corev1.ResourceName]bool, error) {
+	advertised := make(map[corev1.ResourceName]bool)
+
+	nodes := &corev1.NodeList{}
+	for {
+		if err := wh.apiReader.List(ctx, nodes, client.Limit(nodeListPageSize), client.Continue(nodes.Continue)); err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes.Items {
+			for name := range node.Status.Allocatable {
+				advertised[name] = true
+			}
+		}
+
+		if nodes.Continue == "" {
+			return advertised, nil
+		}
+	}
+}
+
+// validateArchitectureConfiguration checks that the machine types set in the architectureConfiguration field match the
+// emulated machine types of their architecture. The emulated machine types are either set in the HyperConverged CR, or
+// the defaults of the architecture.
+func (wh *WebhookHandler) validateArchitectureConfiguration(hc *v1beta1.HyperConverged) error {
+	hcArchConfig := hc.Spec.ArchitectureConfiguration
+	if hcArchConfig == nil {
+		return nil
 	}
 
-	//nolint:staticcheck
-	if hc.Spec.FeatureGates.DeployTektonTaskResources != nil {
-		warnings = append(warnings, fmt.Sprintf(fgDeprecationWarning, "deployTektonTaskResources"))
+	// only the values that are set in the HyperConverged CR are checked; the default machine types are set by the
+	// environment variables of the operator deployment, that are not available in the webhook
+	var errs []error
+	for _, archConfig := range []struct {
+		arch                    string
+		hc                      *v1beta1.ArchSpecificConfiguration
+		defaultEmulatedMachines []string
+	}{
+		{arch: "amd64", hc: hcArchConfig.Amd64, defaultEmulatedMachines: []string{handlers.DefaultAMD64EmulatedQ35Machine, handlers.DefaultAMD64EmulatedPCQ35Machine}},
+		{arch: "arm64", hc: hcArchConfig.Arm64, defaultEmulatedMachines: []string{handlers.DefaultARM64EmulatedMachines}},
+		{arch: nodeinfo.S390X, hc: hcArchConfig.S390x, defaultEmulatedMachines: []string{handlers.DefaultS390XEmulatedMachines}},
+	} {
+		if archConfig.hc == nil || archConfig.hc.MachineType == "" {
+			continue
+		}
+
+		emulatedMachines := archConfig.hc.EmulatedMachines
+		if len(emulatedMachines) == 0 {
+			emulatedMachines = archConfig.defaultEmulatedMachines
+		}
+
+		if err := validateMachineType(archConfig.hc.MachineType, emulatedMachines); err != nil {
+			errs = append(errs, fmt.Errorf("%s.%s.machineType: %w", architectureConfigurationPath, archConfig.arch, err))
+		}
 	}
 
-	//nolint:staticcheck
-	if hc.Spec.FeatureGates.NonRoot != nil {
-		warnings = append(warnings, fmt.Sprintf(fgDeprecationWarning, "nonRoot"))
+	if len(errs) > 0 {
+		return joinErrors(errs)
 	}
 
-	//nolint:staticcheck
-	if hc.Spec.FeatureGates.EnableManagedTenantQuota != nil {
-		warnings = append(warnings, fmt.Sprintf(fgDeprecationWarning, "enableManagedTenantQuota"))
+	return nil
+}
+
+// warnNetworkBindingPlugins warns about network bindings in the networkBinding field, that use the binding name of one
+// of the enabled managed network binding plugins. HCO sets the binding of the plugin in the KubeVirt CR, so the
+// binding in the networkBinding field is ignored.
+func warnNetworkBindingPlugins(hc *v1beta1.HyperConverged) []string {
+	var warnings []string
+	for _, plugin := range handlers.GetEnabledNetworkBindingPlugins(hc) {
+		if _, exists := hc.Spec.NetworkBinding[plugin.BindingName]; exists {
+			warnings = append(warnings, fmt.Sprintf("spec.networkBinding.%[1]s: the %[1]q network binding is managed by the %[2]q network binding plugin; the binding of the plugin is used, and this one is ignored", plugin.BindingName, plugin.Name))
+		}
 	}
 
 	return warnings
 }
 
-func (wh *WebhookHandler) validateAffinity(hc *v1beta1.HyperConverged) error {
-	if hc.Spec.Workloads.NodePlacement != nil {
-		if err := validateAffinity(hc.Spec.Workloads.NodePlacement.Affinity); err != nil {
-			return fmt.Errorf("invalid workloads node placement affinity: %v", err.Error())
+// validateNetworking checks the dependencies between the network components. On OpenShift, multus is deployed by the
+// cluster network operator, so the components that require it can be deployed regardless of spec.networking.multus.
+func (wh *WebhookHandler) validateNetworking(hc *v1beta1.HyperConverged) error {
+	networking := hc.Spec.Networking
+	if networking == nil || wh.isOpenshift {
+		return nil
+	}
+
+	multusDisabled := networking.Multus != nil && !ptr.Deref(networking.Multus.Deploy, true)
+	dynamicNetworksEnabled := networking.MultusDynamicNetworks != nil && ptr.Deref(networking.MultusDynamicNetworks.Deploy, false)
+	if multusDisabled && dynamicNetworksEnabled {
+		return errors.New("spec.networking.multusDynamicNetworks: the multus dynamic networks controller requires multus; spec.networking.multus.deploy must not be false")
+	}
+
+	return nil
+}
+
+const kubeMacPoolConfigurationPath = "spec.kubeMacPoolConfiguration"
+
+// validateKubeMacPoolConfiguration checks that the KubeMacPool MAC address range is valid. KubeMacPool fails to start
+// with an invalid range.
+func validateKubeMacPoolConfiguration(hc *v1beta1.HyperConverged) error {
+	kmpConfig := hc.Spec.KubeMacPoolConfiguration
+	if kmpConfig == nil {
+		return nil
+	}
+
+	if len(kmpConfig.Ranges) > 1 {
+		return fmt.Errorf("%s.ranges: only a single MAC address range is supported, but %d ranges are configured", kubeMacPoolConfigurationPath, len(kmpConfig.Ranges))
+	}
+
+	if len(kmpConfig.Ranges) == 1 {
+		return validateMacAddressRange(kubeMacPoolConfigurationPath+".ranges[0]", "start", kmpConfig.Ranges[0].Start, "end", kmpConfig.Ranges[0].End)
+	}
+
+	if kmpConfig.RangeStart == nil || kmpConfig.RangeEnd == nil {
+		return nil
+	}
+
+	return validateMacAddressRange(kubeMacPoolConfigurationPath, "rangeStart", *kmpConfig.RangeStart, "rangeEnd", *kmpConfig.RangeEnd)
+}
+
+// validateMacAddressRange checks that both ends of a KubeMacPool range are unicast MAC addresses, and that the
+// range is not empty
+func validateMacAddressRange(path, startField, start, endField, end string) error {
+	var errs []error
+	rangeStart, err := parseUnicastMAC(path, startField, start)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	rangeEnd, err := parseUnicastMAC(path, endField, end)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return joinErrors(errs)
+	}
+
+	if bytes.Compare(rangeStart, rangeEnd) >= 0 {
+		return fmt.Errorf("%s: %s (%s) must be lower than %s (%s)", path, startField, rangeStart, endField, rangeEnd)
+	}
+
+	return nil
+}
+
+// parseUnicastMAC parses a MAC address of the KubeMacPool range, and checks that it is a unicast address
+func parseUnicastMAC(path, field, value string) (net.HardwareAddr, error) {
+	mac, err := net.ParseMAC(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s.%s: %w", path, field, err)
+	}
+
+	// the least significant bit of the first octet is the multicast bit
+	if mac[0]&1 != 0 {
+		return nil, fmt.Errorf("%s.%s: %s is a multicast MAC address; KubeMacPool only allocates unicast MAC addresses", path, field, value)
+	}
+
+	return mac, nil
+}
+
+// validateKubeSecondaryDNS checks that the KubeSecondaryDNS name server IP is an IPv4 address. On update, exists is
+// the current CR, and only the modified fields are checked, as the deprecated kubeSecondaryDNSNameServerIP field was
+// not validated in older versions.
+func validateKubeSecondaryDNS(hc, exists *v1beta1.HyperConverged) error {
+	var errs []error
+	if ksd := hc.Spec.KubeSecondaryDNS; ksd != nil && ksd.NameServerIP != "" && !isIPv4(ksd.NameServerIP) &&
+		(exists == nil || exists.Spec.KubeSecondaryDNS == nil || exists.Spec.KubeSecondaryDNS.NameServerIP != ksd.NameServerIP) {
+		errs = append(errs, fmt.Errorf("spec.kubeSecondaryDNS.nameServerIP: %q is not a valid IPv4 address", ksd.NameServerIP))
+	}
+
+	if nameServerIP := ptr.Deref(hc.Spec.KubeSecondaryDNSNameServerIP, ""); nameServerIP != "" && !isIPv4(nameServerIP) &&
+		(exists == nil || ptr.Deref(exists.Spec.KubeSecondaryDNSNameServerIP, "") != nameServerIP) {
+		errs = append(errs, fmt.Errorf("spec.kubeSecondaryDNSNameServerIP: %q is not a valid IPv4 address", nameServerIP))
+	}
+
+	if len(errs) > 0 {
+		return joinErrors(errs)
+	}
+
+	return nil
+}
+
+func isIPv4(ip string) bool {
+	parsed := net.ParseIP(ip)
+	return parsed != nil && parsed.To4() != nil
+}
+
+// validateNetworkPolicies checks the CIDR blocks of spec.networkPolicies, so they are not rejected later when HCO
+// creates the NetworkPolicies
+func validateNetworkPolicies(hc *v1beta1.HyperConverged) error {
+	nps := hc.Spec.NetworkPolicies
+	if nps == nil {
+		return nil
+	}
+
+	var errs []error
+	if nps.APIServer != nil {
+		for i, cidr := range nps.APIServer.CIDRBlocks {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				errs = append(errs, fmt.Errorf("spec.networkPolicies.apiServer.cidrBlocks[%d]: %q is not a valid CIDR", i, cidr))
+			}
 		}
 	}
 
-	if hc.Spec.Infra.NodePlacement != nil {
-		if err := validateAffinity(hc.Spec.Infra.NodePlacement.Affinity); err != nil {
-			return fmt.Errorf("invalid infra node placement affinity: %v", err.Error())
+	errs = append(errs, validateNetworkPolicyPeers("spec.networkPolicies.extraIngressPeers", nps.ExtraIngressPeers)...)
+	errs = append(errs, validateNetworkPolicyPeers("spec.networkPolicies.extraEgressPeers", nps.ExtraEgressPeers)...)
+
+	if len(errs) > 0 {
+		return joinErrors(errs)
+	}
+
+	return nil
+}
+
+func validateNetworkPolicyPeers(fieldPath string, peers []networkingv1.NetworkPolicyPeer) []error {
+	var errs []error
+	for i, peer := range peers {
+		if peer.IPBlock == nil {
+			if peer.PodSelector == nil && peer.NamespaceSelector == nil {
+				errs = append(errs, fmt.Errorf("%s[%d]: one of podSelector, namespaceSelector or ipBlock must be set", fieldPath, i))
+			}
+			continue
+		}
+
+		if peer.PodSelector != nil || peer.NamespaceSelector != nil {
+			errs = append(errs, fmt.Errorf("%s[%d]: ipBlock can't be set together with podSelector or namespaceSelector", fieldPath, i))
+		}
+
+		_, ipNet, err := net.ParseCIDR(peer.IPBlock.CIDR)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d].ipBlock.cidr: %q is not a valid CIDR", fieldPath, i, peer.IPBlock.CIDR))
+			continue
+		}
+
+		for j, except := range peer.IPBlock.Except {
+			exceptIP, _, err := net.ParseCIDR(except)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s[%d].ipBlock.except[%d]: %q is not a valid CIDR", fieldPath, i, j, except))
+			} else if !ipNet.Contains(exceptIP) {
+				errs = append(errs, fmt.Errorf("%s[%d].ipBlock.except[%d]: %q is not within %q", fieldPath, i, j, except, peer.IPBlock.CIDR))
+			}
 		}
 	}
 
+	return errs
+}
+
+// validateBaselineAdminNetworkPolicy checks that the namespace selector of spec.baselineAdminNetworkPolicy is valid
+// and not empty, as an empty selector would deny the traffic of all the namespaces in the cluster
+func validateBaselineAdminNetworkPolicy(hc *v1beta1.HyperConverged) error {
+	banp := hc.Spec.BaselineAdminNetworkPolicy
+	if banp == nil {
+		return nil
+	}
+
+	if len(banp.NamespaceSelector.MatchLabels) == 0 && len(banp.NamespaceSelector.MatchExpressions) == 0 {
+		return errors.New("spec.baselineAdminNetworkPolicy.namespaceSelector: must not be empty")
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(&banp.NamespaceSelector); err != nil {
+		return fmt.Errorf("spec.baselineAdminNetworkPolicy.namespaceSelector: %w", err)
+	}
+
 	return nil
 }
 
-func validateAffinity(affinity *corev1.Affinity) error {
-	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+// validateImportProxy checks that the proxy URLs of spec.importProxy are valid http or https URLs
+func validateImportProxy(hc *v1beta1.HyperConverged) error {
+	importProxy := hc.Spec.ImportProxy
+	if importProxy == nil {
 		return nil
 	}
 
-	_, err := nodeaffinity.NewNodeSelector(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	if err := validateProxyURL("spec.importProxy.HTTPProxy", importProxy.HTTPProxy); err != nil {
+		return err
+	}
 
-	return err
+	return validateProxyURL("spec.importProxy.HTTPSProxy", importProxy.HTTPSProxy)
 }
 
-func validateOldFGOnCreate(warnings []string, hc *v1beta1.HyperConverged) []string {
-	//nolint:staticcheck
-	if hc.Spec.FeatureGates.EnableApplicationAwareQuota != nil {
-		warnings = append(warnings, fmt.Sprintf(fgMovedWarning, "enableApplicationAwareQuota"))
+func validateProxyURL(field string, proxyURL *string) error {
+	if proxyURL == nil || *proxyURL == "" {
+		return nil
 	}
 
-	//nolint:staticcheck
-	if hc.Spec.FeatureGates.EnableCommonBootImageImport != nil {
-		warnings = append(warnings, fmt.Sprintf(fgMovedWarning, "enableCommonBootImageImport"))
+	// don't include the URL in the error message, as it may contain credentials
+	u, err := url.Parse(*proxyURL)
+	if err != nil {
+		return fmt.Errorf("%s: invalid URL", field)
 	}
 
-	//nolint:staticcheck
-	if hc.Spec.FeatureGates.DeployVMConsoleProxy != nil {
-		warnings = append(warnings, fmt.Sprintf(fgMovedWarning, "deployVmConsoleProxy"))
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s: must be an http or https URL, with a host", field)
 	}
 
-	return warnings
+	return nil
 }
 
-func validateOldFGOnUpdate(warnings []string, hc, prevHC *v1beta1.HyperConverged) []string {
-	//nolint:staticcheck
-	if oldFGChanged(hc.Spec.FeatureGates.EnableApplicationAwareQuota, prevHC.Spec.FeatureGates.EnableApplicationAwareQuota) {
-		warnings = append(warnings, fmt.Sprintf(fgMovedWarning, "enableApplicationAwareQuota"))
+func validateMachineType(machineType string, emulatedMachines []string) error {
+	for _, pattern := range emulatedMachines {
+		matched, err := path.Match(pattern, machineType)
+		if err != nil {
+			return fmt.Errorf("invalid emulated machine type pattern %q: %w", pattern, err)
+		}
+
+		if matched {
+			return nil
+		}
 	}
 
-	//nolint:staticcheck
-	if oldFGChanged(hc.Spec.FeatureGates.EnableCommonBootImageImport, prevHC.Spec.FeatureGates.EnableCommonBootImageImport) {
-		warnings = append(warnings, fmt.Sprintf(fgMovedWarning, "enableCommonBootImageImport"))
+	return fmt.Errorf("the %q machine type does not match any of the emulated machine types %v", machineType, emulatedMachines)
+}
+
+// warnMissingArchitectures warns about architecture configurations of CPU architectures that none of the workloads
+// nodes has. This is not an error: nodes with this architecture may be added to the cluster later.
+func warnMissingArchitectures(hc *v1beta1.HyperConverged) []string {
+	hcArchConfig := hc.Spec.ArchitectureConfiguration
+	workloadsArchitectures := hc.Status.NodeInfo.WorkloadsArchitectures
+	if hcArchConfig == nil || len(workloadsArchitectures) == 0 {
+		return nil
 	}
 
-	//nolint:staticcheck
-	if oldFGChanged(hc.Spec.FeatureGates.DeployVMConsoleProxy, prevHC.Spec.FeatureGates.DeployVMConsoleProxy) {
-		warnings = append(warnings, fmt.Sprintf(fgMovedWarning, "deployVmConsoleProxy"))
+	var warnings []string
+	for _, archConfig := range []struct {
+		arch       string
+		configured bool
+	}{
+		{arch: "amd64", configured: hcArchConfig.Amd64 != nil},
+		{arch: "arm64", configured: hcArchConfig.Arm64 != nil},
+		{arch: nodeinfo.S390X, configured: hcArchConfig.S390x != nil},
+	} {
+		if archConfig.configured && !slices.Contains(workloadsArchitectures, archConfig.arch) {
+			warnings = append(warnings, fmt.Sprintf("%s.%s is set, but none of the workloads nodes has the %s architecture", architectureConfigurationPath, archConfig.arch, archConfig.arch))
+		}
 	}
 
 	return warnings
 }
 
-func oldFGChanged(newFG, prevFG *bool) bool {
-	return newFG != nil && (prevFG == nil || *newFG != *prevFG)
+func warnTuningPolicy(hc *v1beta1.HyperConverged) []string {
+	if hc.Spec.TuningPolicy == v1beta1.HyperConvergedHighBurstProfile { //nolint SA1019
+		return []string{"spec.tuningPolicy: the highBurst profile is deprecated as of v1.16.0 and will be removed in a future release"}
+	}
+	return nil
+}
+
+func (wh *WebhookHandler) validateAffinity(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.Workloads.NodePlacement != nil {
+		if err := validateAffinity(hc.Spec.Workloads.NodePlacement.Affinity); err != nil {
+			return fmt.Errorf("invalid workloads node placement affinity: %v", err.Error())
+		}
+	}
+
+	if hc.Spec.Infra.NodePlacement != nil {
+		if err := validateAffinity(hc.Spec.Infra.NodePlacement.Affinity); err != nil {
+			return fmt.Errorf("invalid infra node placement affinity: %v", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func validateAffinity(affinity *corev1.Affinity) error {
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return nil
+	}
+
+	_, err := nodeaffinity.NewNodeSelector(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+
+	return err
 }
 
 func hasRequiredHTTP2Ciphers(ciphers []string) bool {
@@ -608,13 +1146,9 @@ func validationResponseFromStatus(allowed bool, status metav1.Status) admission.
 
 func SelectCipherSuitesAndMinTLSVersion() ([]string, openshiftconfigv1.TLSProtocolVersion) {
 	ci := hcoutil.GetClusterInfo()
-	profile := ci.GetTLSSecurityProfile(hcoTLSConfigCache)
-
-	if profile.Custom != nil {
-		return profile.Custom.Ciphers, profile.Custom.MinTLSVersion
-	}
+	profileSpec := hcoutil.GetTLSProfileSpec(ci.GetTLSSecurityProfile(hcoTLSConfigCache))
 
-	return openshiftconfigv1.TLSProfiles[profile.Type].Ciphers, openshiftconfigv1.TLSProfiles[profile.Type].MinTLSVersion
+	return profileSpec.Ciphers, profileSpec.MinTLSVersion
 }
 
 func isValidTLSProtocolVersion(pv openshiftconfigv1.TLSProtocolVersion) bool {
@@ -67,7 +67,6 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 	Context("feature gate defaults", func() {
 		defaultFeatureGates := v1beta1.HyperConvergedFeatureGates{
 			DownwardMetrics:                ptr.To(false),
-			DeployKubeSecondaryDNS:         ptr.To(false),
 			DisableMDevConfiguration:       ptr.To(false),
 			PersistentReservation:          ptr.To(false),
 			AlignCPUs:                      ptr.To(false),
@@ -90,7 +89,6 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 			}).WithTimeout(2 * time.Second).WithPolling(100 * time.Millisecond).WithContext(ctx).Should(Succeed())
 		},
 			Entry("when removing /spec/featureGates/downwardMetrics", "/spec/featureGates/downwardMetrics"),
-			Entry("when removing /spec/featureGates/deployKubeSecondaryDNS", "/spec/featureGates/deployKubeSecondaryDNS"),
 			Entry("when removing /spec/featureGates/persistentReservation", "/spec/featureGates/persistentReservation"),
 			Entry("when removing /spec/featureGates/alignCPUs", "/spec/featureGates/alignCPUs"),
 			Entry("when removing /spec/featureGates/enableMultiArchBootImageImport", "/spec/featureGates/enableMultiArchBootImageImport"),
@@ -162,6 +160,7 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 			BatchEvictionInterval: &metav1.Duration{Duration: time.Minute},
 			BatchEvictionSize:     ptr.To(10),
 			WorkloadUpdateMethods: []string{"LiveMigrate"},
+			UpdateStallThreshold:  &metav1.Duration{Duration: time.Hour},
 		}
 
 		DescribeTable("Check that workloadUpdateStrategy defaults are behaving as expected", func(ctx context.Context, path string) {
@@ -177,6 +176,7 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 		},
 			Entry("when removing /spec/workloadUpdateStrategy/batchEvictionInterval", "/spec/workloadUpdateStrategy/batchEvictionInterval"),
 			Entry("when removing /spec/workloadUpdateStrategy/batchEvictionSize", "/spec/workloadUpdateStrategy/batchEvictionSize"),
+			Entry("when removing /spec/workloadUpdateStrategy/updateStallThreshold", "/spec/workloadUpdateStrategy/updateStallThreshold"),
 			Entry("when removing /spec/workloadUpdateStrategy/workloadUpdateMethods", "/spec/workloadUpdateStrategy/workloadUpdateMethods"),
 			Entry("when removing /spec/workloadUpdateStrategy", "/spec/workloadUpdateStrategy"),
 			Entry("when removing /spec", "/spec"),
@@ -35,6 +35,8 @@ import (
 
 	"golang.org/x/mod/modfile"
 	"golang.org/x/mod/semver"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
 )
 
 var (
@@ -380,6 +382,44 @@ func setK8sLinks() error {
 //go:embed api.md.gotemplate
 var templateFile embed.FS
 
+type apiDocs struct {
+	Types        []KubeTypes
+	FeatureGates []featureGateInfo
+}
+
+type featureGateInfo struct {
+	Name          string
+	Maturity      featuregates.Maturity
+	Default       bool
+	KubeVirtGates string
+	CDIGates      string
+	ReplacedBy    string
+}
+
+// getFeatureGatesInfo lists the feature gates from the feature gates registry, rather than from the API types, to also
+// document their maturity and the operand feature gates they enable
+func getFeatureGatesInfo() []featureGateInfo {
+	fgs := featuregates.All()
+	infos := make([]featureGateInfo, 0, len(fgs))
+	for _, fg := range fgs {
+		kvGates := slices.Clone(fg.KubeVirtGates)
+		for _, gate := range fg.KubeVirtGatesWhenDisabled {
+			kvGates = append(kvGates, gate+" (when disabled)")
+		}
+
+		infos = append(infos, featureGateInfo{
+			Name:          fg.Name,
+			Maturity:      fg.Maturity,
+			Default:       fg.Default,
+			KubeVirtGates: strings.Join(kvGates, ", "),
+			CDIGates:      strings.Join(fg.CDIGates, ", "),
+			ReplacedBy:    fg.ReplacedBy,
+		})
+	}
+
+	return infos
+}
+
 func printAPIDocs(w io.Writer, types []KubeTypes) error {
 	funcMap := template.FuncMap{
 		"ToLower": strings.ToLower,
@@ -396,7 +436,12 @@ func printAPIDocs(w io.Writer, types []KubeTypes) error {
 		return err
 	}
 
-	err = tmplt.Execute(w, types)
+	docs := apiDocs{
+		Types:        types,
+		FeatureGates: getFeatureGatesInfo(),
+	}
+
+	err = tmplt.Execute(w, docs)
 	if err != nil {
 		return err
 	}